@@ -4,15 +4,20 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"syscall"
 	"time"
 
+	"github.com/stateshape/augur-analyzer/pkg/alerts"
+	"github.com/stateshape/augur-analyzer/pkg/api"
 	"github.com/stateshape/augur-analyzer/pkg/env"
 	"github.com/stateshape/augur-analyzer/pkg/gcloud"
 	"github.com/stateshape/augur-analyzer/pkg/markets"
 	"github.com/stateshape/augur-analyzer/pkg/pricing"
 	"github.com/stateshape/augur-analyzer/pkg/proto/augur"
+	"github.com/stateshape/augur-analyzer/pkg/timeseries"
 	"github.com/stateshape/augur-analyzer/pkg/web3"
+	"github.com/stateshape/augur-analyzer/pkg/webhooks"
 
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
@@ -34,6 +39,23 @@ func environment() {
 	viper.SetDefault(env.GCloudProjectID, "")
 	viper.SetDefault(env.GCloudStorageBucket, "")
 	viper.SetDefault(env.DebugMarkets, "")
+	viper.SetDefault(env.MarketEventPredictionThreshold, 10.0)
+	viper.SetDefault(env.DataDir, "data")
+	viper.SetDefault(env.WebhooksConfig, "")
+	viper.SetDefault(env.AlertRulesConfig, "")
+	viper.SetDefault(env.TimeSeriesRawRetention, "168h")
+	viper.SetDefault(env.TimeSeriesRetention, "8760h")
+	viper.SetDefault(env.PredictionMethod, "LAST_TRADE_PRICE")
+	viper.SetDefault(env.PredictionWindow, "24h")
+	viper.SetDefault(env.PredictionBlendTradeWeight, 0.5)
+	viper.SetDefault(env.LiquidityConfig, "")
+	viper.SetDefault(env.LiquidityMillietherTranches, "1000,10000,50000,250000")
+	viper.SetDefault(env.LiquidityUSDTranches, "")
+	viper.SetDefault(env.LiquidityFees, true)
+	viper.SetDefault(env.GasPerFill, markets.DefaultGasPerFill)
+	viper.SetDefault(env.PriceImpactShareLadder, markets.DefaultPriceImpactShareLadder)
+	viper.SetDefault(env.PriceImpactEtherLadder, markets.DefaultPriceImpactEtherLadder)
+	viper.SetDefault(env.Workers, 0)
 	viper.AutomaticEnv()
 
 	required := []string{
@@ -88,10 +110,97 @@ func main() {
 
 	// Start watching the chain
 	watcher := markets.NewWatcher(pricingAPI, web3API, augurAPI, objectUploader)
+
+	// Prediction method of markets with volume
+	predictionMethod, err := markets.ParsePredictionMethod(viper.GetString(env.PredictionMethod))
+	if err != nil {
+		logrus.WithError(err).Panicf("Failed to parse prediction method")
+	}
+	watcher.Predictions = markets.PredictionConfig{
+		Method:           predictionMethod,
+		Window:           viper.GetDuration(env.PredictionWindow),
+		BlendTradeWeight: viper.GetFloat64(env.PredictionBlendTradeWeight),
+	}
+
+	// Liquidity tranches, the config file overrides the environment
+	liquidityConfig, err := markets.ParseLiquidityConfig(
+		viper.GetString(env.LiquidityMillietherTranches),
+		viper.GetString(env.LiquidityUSDTranches),
+	)
+	if err != nil {
+		logrus.WithError(err).Panicf("Failed to parse liquidity config")
+	}
+	if viper.GetString(env.LiquiditySellingIncrement) != "" {
+		logrus.Warnf("Ignoring environment variable `%s`, complete sets are liquidated exactly", env.LiquiditySellingIncrement)
+	}
+	if viper.GetString(env.LiquidityConfig) != "" {
+		liquidityConfig, err = markets.LoadLiquidityConfig(viper.GetString(env.LiquidityConfig), liquidityConfig)
+		if err != nil {
+			logrus.WithError(err).Panicf("Failed to load liquidity config")
+		}
+	}
+	liquidityConfig.Fees = viper.GetBool(env.LiquidityFees)
+	watcher.Liquidity = liquidityConfig
+	watcher.Gas.GasPerFill = uint64(viper.GetInt64(env.GasPerFill))
+
+	// Sizes of the market orders of price impact curves
+	priceImpactConfig, err := markets.ParsePriceImpactConfig(
+		viper.GetString(env.PriceImpactShareLadder),
+		viper.GetString(env.PriceImpactEtherLadder),
+	)
+	if err != nil {
+		logrus.WithError(err).Panicf("Failed to parse price impact ladders")
+	}
+	watcher.PriceImpact = priceImpactConfig
+
+	// Workers translating markets, GOMAXPROCS unless configured
+	watcher.Workers = markets.NewWorkerPool(viper.GetInt(env.Workers))
+
+	// Webhooks for market events
+	if viper.GetString(env.WebhooksConfig) != "" {
+		subscriptions, err := webhooks.LoadSubscriptions(viper.GetString(env.WebhooksConfig))
+		if err != nil {
+			logrus.WithError(err).Panicf("Failed to load webhook subscriptions")
+		}
+		dispatcher, err := webhooks.NewDispatcher(subscriptions, filepath.Join(viper.GetString(env.DataDir), "webhooks"))
+		if err != nil {
+			logrus.WithError(err).Panicf("Failed to create webhook dispatcher")
+		}
+		watcher.Webhooks = dispatcher
+		go dispatcher.Run(webhooks.FlushInterval, nil)
+	}
+
+	// Alert rules, more can be added over HTTP
+	if viper.GetString(env.AlertRulesConfig) != "" {
+		rules, err := alerts.LoadRules(viper.GetString(env.AlertRulesConfig))
+		if err != nil {
+			logrus.WithError(err).Panicf("Failed to load alert rules")
+		}
+		watcher.Alerts = alerts.NewEngine(rules)
+	}
+
+	// Time series of market metrics
+	store, err := timeseries.NewStore(
+		filepath.Join(viper.GetString(env.DataDir), "timeseries"),
+		viper.GetDuration(env.TimeSeriesRawRetention),
+		viper.GetDuration(env.TimeSeriesRetention),
+	)
+	if err != nil {
+		logrus.WithError(err).Panicf("Failed to create time series store")
+	}
+	watcher.TimeSeries = store
+
+	// History of the universe
+	history, err := markets.NewUniverseHistoryRecorder(filepath.Join(viper.GetString(env.DataDir), "universe-history.json"))
+	if err != nil {
+		logrus.WithError(err).Panicf("Failed to create universe history recorder")
+	}
+	watcher.History = history
 	go watcher.Watch()
 
 	// Start HTTP server
 	r := gin.Default()
+	api.NewServer(watcher).Register(r)
 	r.Run(fmt.Sprintf("%s:%s", viper.GetString(env.HTTPServerNetworkInterface), viper.GetString(env.HTTPServerPort)))
 
 	// Wait for OS termination signal
@@ -0,0 +1,265 @@
+package alerts
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/stateshape/augur-analyzer/pkg/proto/markets"
+
+	"github.com/pborman/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// Number of most recent alerts kept in memory
+const MaxAlertLogLength = 1000
+
+// Alert is fired when the condition of a rule starts to hold
+type Alert struct {
+	ID     string    `json:"id"`
+	RuleID string    `json:"ruleId"`
+	Block  uint64    `json:"block"`
+	Time   time.Time `json:"time"`
+	// Value which triggered the rule and the value it was compared against
+	Value     float64 `json:"value"`
+	Reference float64 `json:"reference"`
+	Message   string  `json:"message"`
+}
+
+type sample struct {
+	Time  time.Time
+	Value float64
+}
+
+type ruleState struct {
+	Samples   []sample
+	Firing    bool
+	LastFired time.Time
+}
+
+// Engine evaluates alert rules against every new markets summary. An alert
+// is fired once when the condition of a rule starts to hold and not again
+// until the condition cleared and the cooldown of the rule elapsed.
+type Engine struct {
+	mu     sync.Mutex
+	rules  map[string]*Rule
+	states map[string]*ruleState
+	alerts []*Alert
+}
+
+func NewEngine(rules []*Rule) *Engine {
+	e := &Engine{
+		rules:  map[string]*Rule{},
+		states: map[string]*ruleState{},
+		alerts: []*Alert{},
+	}
+	for _, rule := range rules {
+		e.rules[rule.ID] = rule
+		e.states[rule.ID] = &ruleState{}
+	}
+	return e
+}
+
+// Rules returns the rules of the engine sorted by id
+func (e *Engine) Rules() []*Rule {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	rules := []*Rule{}
+	for _, rule := range e.rules {
+		rules = append(rules, rule)
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].ID < rules[j].ID })
+	return rules
+}
+
+// SetRule adds a rule or replaces the rule with the same id
+func (e *Engine) SetRule(rule *Rule) error {
+	if err := rule.Validate(); err != nil {
+		return err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.rules[rule.ID] = rule
+	e.states[rule.ID] = &ruleState{}
+	return nil
+}
+
+// DeleteRule removes a rule, returning false if it does not exist
+func (e *Engine) DeleteRule(id string) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if _, ok := e.rules[id]; !ok {
+		return false
+	}
+	delete(e.rules, id)
+	delete(e.states, id)
+	return true
+}
+
+// Alerts returns the alerts fired after the time provided, oldest first
+func (e *Engine) Alerts(since time.Time) []*Alert {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	alerts := []*Alert{}
+	for _, alert := range e.alerts {
+		if alert.Time.After(since) {
+			alerts = append(alerts, alert)
+		}
+	}
+	return alerts
+}
+
+// Evaluate evaluates every rule against the markets of a block and returns
+// the alerts fired
+func (e *Engine) Evaluate(block uint64, now time.Time, ms []*markets.Market, ethusd float64) []*Alert {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	byID := map[string]*markets.Market{}
+	for _, m := range ms {
+		byID[m.Id] = m
+	}
+
+	ids := []string{}
+	for id := range e.rules {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	fired := []*Alert{}
+	for _, id := range ids {
+		rule, state := e.rules[id], e.states[id]
+		alert, ok := evaluate(rule, state, byID, ethusd, now)
+		if !ok {
+			// Keep the state of rules about markets missing from this block
+			continue
+		}
+		if alert == nil {
+			state.Firing = false
+			continue
+		}
+		if state.Firing || (!state.LastFired.IsZero() && now.Sub(state.LastFired) < rule.cooldown()) {
+			continue
+		}
+		state.Firing = true
+		state.LastFired = now
+		alert.ID = uuid.New()
+		alert.RuleID = rule.ID
+		alert.Block = block
+		alert.Time = now
+		fired = append(fired, alert)
+
+		logrus.WithFields(logrus.Fields{
+			"ruleId":    alert.RuleID,
+			"block":     alert.Block,
+			"value":     alert.Value,
+			"reference": alert.Reference,
+		}).Warnf("Alert fired: %s", alert.Message)
+	}
+
+	e.alerts = append(e.alerts, fired...)
+	if len(e.alerts) > MaxAlertLogLength {
+		e.alerts = e.alerts[len(e.alerts)-MaxAlertLogLength:]
+	}
+	return fired
+}
+
+// evaluate returns an alert without identity if the condition of the rule
+// holds, and false if the rule could not be evaluated
+func evaluate(rule *Rule, state *ruleState, byID map[string]*markets.Market, ethusd float64, now time.Time) (*Alert, bool) {
+	switch rule.Kind {
+	case PredictionMove:
+		m, ok := byID[rule.MarketID]
+		if !ok {
+			return nil, false
+		}
+		value, ok := getPrediction(m, rule.OutcomeID)
+		if !ok {
+			return nil, false
+		}
+		reference, moved := observe(state, rule.Window.Duration, now, value, func(previous float64) float64 {
+			return math.Abs(value - previous)
+		}, rule.Threshold)
+		if !moved {
+			return nil, true
+		}
+		return &Alert{
+			Value:     value,
+			Reference: reference,
+			Message: fmt.Sprintf("Prediction for outcome %d of market %s moved from %.2f to %.2f within %s",
+				rule.OutcomeID, rule.MarketID, reference, value, rule.Window.Duration),
+		}, true
+
+	case RetentionRatioBelow:
+		m, ok := byID[rule.MarketID]
+		if !ok || m.LiquidityMetrics == nil {
+			return nil, false
+		}
+		value, ok := m.LiquidityMetrics.RetentionRatioByMillietherTranche[rule.MillietherTranche]
+		if !ok {
+			return nil, false
+		}
+		if float64(value) >= rule.Threshold {
+			return nil, true
+		}
+		return &Alert{
+			Value:     float64(value),
+			Reference: rule.Threshold,
+			Message: fmt.Sprintf("Retention ratio of market %s at the %d milliether tranche dropped to %.4f",
+				rule.MarketID, rule.MillietherTranche, value),
+		}, true
+
+	case ExchangeRateDeviation:
+		if ethusd <= 0 {
+			return nil, false
+		}
+		reference, deviated := observe(state, rule.Window.Duration, now, ethusd, func(previous float64) float64 {
+			return math.Abs(ethusd/previous-1) * 100
+		}, rule.Threshold)
+		if !deviated {
+			return nil, true
+		}
+		return &Alert{
+			Value:     ethusd,
+			Reference: reference,
+			Message:   fmt.Sprintf("ETH/USD exchange rate moved from %.2f to %.2f within %s", reference, ethusd, rule.Window.Duration),
+		}, true
+	}
+	return nil, false
+}
+
+// observe records a sample and compares the value against the samples within
+// the window, returning the sample with the largest change if it exceeds the
+// threshold
+func observe(state *ruleState, window time.Duration, now time.Time, value float64, change func(previous float64) float64, threshold float64) (float64, bool) {
+	samples := []sample{}
+	for _, s := range state.Samples {
+		if now.Sub(s.Time) <= window {
+			samples = append(samples, s)
+		}
+	}
+	state.Samples = append(samples, sample{Time: now, Value: value})
+
+	reference, largest := 0.0, 0.0
+	for _, s := range samples {
+		if c := change(s.Value); c > largest {
+			reference, largest = s.Value, c
+		}
+	}
+	return reference, largest > threshold
+}
+
+func getPrediction(m *markets.Market, outcomeID uint64) (float64, bool) {
+	for _, prediction := range m.Predictions {
+		if prediction.OutcomeId != outcomeID || prediction.IsInvalid {
+			continue
+		}
+		if m.MarketType == markets.MarketType_SCALAR {
+			return float64(prediction.Value), true
+		}
+		return float64(prediction.Percent), true
+	}
+	return 0, false
+}
@@ -0,0 +1,135 @@
+package alerts_test
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stateshape/augur-analyzer/pkg/alerts"
+	"github.com/stateshape/augur-analyzer/pkg/proto/markets"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnginePredictionMove(t *testing.T) {
+	engine := alerts.NewEngine([]*alerts.Rule{{
+		ID:        "move",
+		Kind:      alerts.PredictionMove,
+		MarketID:  "m",
+		OutcomeID: 1,
+		Threshold: 10,
+		Window:    alerts.Duration{Duration: time.Hour},
+		Cooldown:  alerts.Duration{Duration: 3 * time.Hour},
+	}})
+	market := func(percent float32) []*markets.Market {
+		return []*markets.Market{{
+			Id:          "m",
+			MarketType:  markets.MarketType_YESNO,
+			Predictions: []*markets.Prediction{{OutcomeId: 1, Percent: percent}},
+		}}
+	}
+
+	now := time.Unix(0, 0)
+	assert.Equal(t, 0, len(engine.Evaluate(1, now, market(50), 500)))
+	assert.Equal(t, 0, len(engine.Evaluate(2, now.Add(30*time.Minute), market(55), 500)))
+
+	fired := engine.Evaluate(3, now.Add(45*time.Minute), market(62), 500)
+	assert.Equal(t, 1, len(fired))
+	assert.Equal(t, "move", fired[0].RuleID)
+	assert.Equal(t, uint64(3), fired[0].Block)
+	assert.Equal(t, float64(62), fired[0].Value)
+	assert.Equal(t, float64(50), fired[0].Reference)
+
+	// De-duplicated while the condition holds
+	assert.Equal(t, 0, len(engine.Evaluate(4, now.Add(50*time.Minute), market(63), 500)))
+
+	// Samples older than the window are forgotten, which clears the condition
+	assert.Equal(t, 0, len(engine.Evaluate(5, now.Add(3*time.Hour), market(63), 500)))
+
+	// The condition holds again but the cooldown has not elapsed
+	assert.Equal(t, 0, len(engine.Evaluate(6, now.Add(3*time.Hour+10*time.Minute), market(80), 500)))
+	assert.Equal(t, 1, len(engine.Alerts(time.Unix(0, 0))))
+}
+
+func TestEngineIgnoresInvalidEstimate(t *testing.T) {
+	// The estimate of an invalid resolution takes the outcome id after the
+	// last outcome of the market
+	engine := alerts.NewEngine([]*alerts.Rule{{
+		ID:        "invalid",
+		Kind:      alerts.PredictionMove,
+		MarketID:  "m",
+		OutcomeID: 2,
+		Threshold: 10,
+		Window:    alerts.Duration{Duration: time.Hour},
+	}})
+	market := func(percent float32) []*markets.Market {
+		return []*markets.Market{{
+			Id:         "m",
+			MarketType: markets.MarketType_YESNO,
+			Predictions: []*markets.Prediction{
+				{OutcomeId: 1, Percent: 50},
+				{OutcomeId: 2, Percent: percent, IsInvalid: true},
+			},
+		}}
+	}
+
+	now := time.Unix(0, 0)
+	assert.Equal(t, 0, len(engine.Evaluate(1, now, market(2), 500)))
+	assert.Equal(t, 0, len(engine.Evaluate(2, now.Add(time.Minute), market(50), 500)))
+}
+
+func TestEngineRetentionRatioAndExchangeRate(t *testing.T) {
+	engine := alerts.NewEngine(nil)
+	assert.Nil(t, engine.SetRule(&alerts.Rule{
+		ID:                "liquidity",
+		Kind:              alerts.RetentionRatioBelow,
+		MarketID:          "m",
+		MillietherTranche: 50000,
+		Threshold:         0.8,
+	}))
+	assert.Nil(t, engine.SetRule(&alerts.Rule{
+		ID:        "ethusd",
+		Kind:      alerts.ExchangeRateDeviation,
+		Threshold: 5,
+		Window:    alerts.Duration{Duration: time.Hour},
+	}))
+	assert.NotNil(t, engine.SetRule(&alerts.Rule{ID: "invalid", Kind: alerts.RetentionRatioBelow}))
+	assert.Equal(t, 2, len(engine.Rules()))
+
+	market := func(rr float32) []*markets.Market {
+		return []*markets.Market{{
+			Id: "m",
+			LiquidityMetrics: &markets.LiquidityMetrics{
+				RetentionRatioByMillietherTranche: map[uint64]float32{50000: rr},
+			},
+		}}
+	}
+
+	now := time.Unix(0, 0)
+	assert.Equal(t, 0, len(engine.Evaluate(1, now, market(0.9), 500)))
+
+	fired := engine.Evaluate(2, now.Add(time.Minute), market(0.7), 530)
+	assert.Equal(t, 2, len(fired))
+	assert.Equal(t, "ethusd", fired[0].RuleID)
+	assert.Equal(t, float64(500), fired[0].Reference)
+	assert.Equal(t, "liquidity", fired[1].RuleID)
+
+	assert.True(t, engine.DeleteRule("liquidity"))
+	assert.False(t, engine.DeleteRule("liquidity"))
+}
+
+func TestLoadRules(t *testing.T) {
+	f, err := ioutil.TempFile("", "rules")
+	assert.Nil(t, err)
+	defer os.Remove(f.Name())
+	_, err = f.WriteString(`[{"id": "ethusd", "kind": "EXCHANGE_RATE_DEVIATION", "threshold": 5, "window": "1h", "cooldown": "30m"}]`)
+	assert.Nil(t, err)
+	f.Close()
+
+	rules, err := alerts.LoadRules(f.Name())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(rules))
+	assert.Equal(t, time.Hour, rules[0].Window.Duration)
+	assert.Equal(t, 30*time.Minute, rules[0].Cooldown.Duration)
+}
@@ -0,0 +1,116 @@
+package alerts
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"time"
+)
+
+const (
+	// Fires when the prediction of an outcome moves more than Threshold
+	// percentage points within Window. Scalar markets use their value.
+	PredictionMove = "PREDICTION_MOVE"
+	// Fires when the retention ratio of a market at MillietherTranche
+	// drops below Threshold
+	RetentionRatioBelow = "RETENTION_RATIO_BELOW"
+	// Fires when the ETH/USD exchange rate deviates more than Threshold
+	// percent from any rate observed within Window
+	ExchangeRateDeviation = "EXCHANGE_RATE_DEVIATION"
+
+	// Cooldown of rules which do not set one
+	DefaultCooldown = time.Hour
+)
+
+// Duration is a time.Duration read from and written to JSON as a string
+// such as "1h30m"
+type Duration struct {
+	time.Duration
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	duration, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	d.Duration = duration
+	return nil
+}
+
+// Rule describes a condition evaluated against every new markets summary
+type Rule struct {
+	ID                string   `json:"id"`
+	Kind              string   `json:"kind"`
+	MarketID          string   `json:"marketId,omitempty"`
+	OutcomeID         uint64   `json:"outcomeId,omitempty"`
+	MillietherTranche uint64   `json:"millietherTranche,omitempty"`
+	Threshold         float64  `json:"threshold"`
+	Window            Duration `json:"window,omitempty"`
+	// Minimum time between two alerts of the rule
+	Cooldown Duration `json:"cooldown,omitempty"`
+}
+
+// Validate checks that the rule has everything its kind requires
+func (r *Rule) Validate() error {
+	if r.ID == "" {
+		return fmt.Errorf("Alert rules require an id")
+	}
+	switch r.Kind {
+	case PredictionMove:
+		if r.MarketID == "" || r.Window.Duration <= 0 {
+			return fmt.Errorf("Alert rule %s requires a market id and a window", r.ID)
+		}
+	case RetentionRatioBelow:
+		if r.MarketID == "" || r.MillietherTranche == 0 {
+			return fmt.Errorf("Alert rule %s requires a market id and a milliether tranche", r.ID)
+		}
+	case ExchangeRateDeviation:
+		if r.Window.Duration <= 0 {
+			return fmt.Errorf("Alert rule %s requires a window", r.ID)
+		}
+	default:
+		return fmt.Errorf("Unknown kind of alert rule %s: %s", r.ID, r.Kind)
+	}
+	if r.Threshold <= 0 {
+		return fmt.Errorf("Alert rule %s requires a positive threshold", r.ID)
+	}
+	return nil
+}
+
+func (r *Rule) cooldown() time.Duration {
+	if r.Cooldown.Duration <= 0 {
+		return DefaultCooldown
+	}
+	return r.Cooldown.Duration
+}
+
+// LoadRules reads a JSON list of alert rules from a file
+func LoadRules(path string) ([]*Rule, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	rules := []*Rule{}
+	if err := json.Unmarshal(content, &rules); err != nil {
+		return nil, err
+	}
+	ids := map[string]struct{}{}
+	for _, rule := range rules {
+		if err := rule.Validate(); err != nil {
+			return nil, err
+		}
+		if _, ok := ids[rule.ID]; ok {
+			return nil, fmt.Errorf("Duplicate alert rule id: %s", rule.ID)
+		}
+		ids[rule.ID] = struct{}{}
+	}
+	return rules, nil
+}
@@ -0,0 +1,170 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/stateshape/augur-analyzer/pkg/alerts"
+	"github.com/stateshape/augur-analyzer/pkg/currency"
+	"github.com/stateshape/augur-analyzer/pkg/markets"
+	protomarkets "github.com/stateshape/augur-analyzer/pkg/proto/markets"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/sirupsen/logrus"
+)
+
+// Server exposes the state of the watcher over HTTP
+type Server struct {
+	Watcher *markets.Watcher
+}
+
+func NewServer(watcher *markets.Watcher) *Server {
+	return &Server{watcher}
+}
+
+func (s *Server) Register(r gin.IRouter) {
+	r.GET("/events", s.getEvents)
+	r.GET("/webhooks/deliveries", s.getWebhookDeliveries)
+	r.GET("/alerts", s.getAlerts)
+	r.GET("/alerts/rules", s.getAlertRules)
+	r.POST("/alerts/rules", s.postAlertRule)
+	r.DELETE("/alerts/rules/:id", s.deleteAlertRule)
+	r.GET("/markets/:id/history", s.getMarketHistory)
+	r.GET("/markets/:id/liquidity/trace", s.getLiquidityTrace)
+	r.GET("/makers/:address", s.getMarketMaker)
+}
+
+// getEvents returns the market events of blocks after the optional `since` block
+func (s *Server) getEvents(c *gin.Context) {
+	since, err := strconv.ParseUint(c.DefaultQuery("since", "0"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "`since` must be a block number"})
+		return
+	}
+	writeProto(c, &protomarkets.MarketEvents{
+		Events: s.Watcher.Events.Since(since),
+	})
+}
+
+// getWebhookDeliveries returns the most recent webhook delivery attempts
+func (s *Server) getWebhookDeliveries(c *gin.Context) {
+	if s.Watcher.Webhooks == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "webhooks are not configured"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"pending":    s.Watcher.Webhooks.Pending(),
+		"deliveries": s.Watcher.Webhooks.DeliveryLog(),
+	})
+}
+
+// getAlerts returns the alerts fired after the optional `since` unix time
+func (s *Server) getAlerts(c *gin.Context) {
+	since, err := strconv.ParseInt(c.DefaultQuery("since", "0"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "`since` must be a unix time"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"alerts": s.Watcher.Alerts.Alerts(time.Unix(since, 0))})
+}
+
+func (s *Server) getAlertRules(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"rules": s.Watcher.Alerts.Rules()})
+}
+
+// postAlertRule adds an alert rule or replaces the rule with the same id
+func (s *Server) postAlertRule(c *gin.Context) {
+	rule := &alerts.Rule{}
+	if err := json.NewDecoder(c.Request.Body).Decode(rule); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := s.Watcher.Alerts.SetRule(rule); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, rule)
+}
+
+func (s *Server) deleteAlertRule(c *gin.Context) {
+	if !s.Watcher.Alerts.DeleteRule(c.Param("id")) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "alert rule not found"})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// getMarketHistory returns the stored metrics of a market between the optional
+// `from` and `to` unix times, defaulting to the last day
+func (s *Server) getMarketHistory(c *gin.Context) {
+	if s.Watcher.TimeSeries == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "the time series store is not configured"})
+		return
+	}
+	now := time.Now()
+	from, err := strconv.ParseInt(c.DefaultQuery("from", strconv.FormatInt(now.Add(-24*time.Hour).Unix(), 10)), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "`from` must be a unix time"})
+		return
+	}
+	to, err := strconv.ParseInt(c.DefaultQuery("to", strconv.FormatInt(now.Unix(), 10)), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "`to` must be a unix time"})
+		return
+	}
+	points, err := s.Watcher.TimeSeries.Range(c.Param("id"), time.Unix(from, 0), time.Unix(to, 0))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"marketId": c.Param("id"), "points": points})
+}
+
+// getLiquidityTrace returns every increment of the liquidity retention
+// of a market as of the last processed block, for an allowance of the optional
+// `milliether`, defaulting to 1 ETH
+func (s *Server) getLiquidityTrace(c *gin.Context) {
+	milliether, err := strconv.ParseUint(c.DefaultQuery("milliether", "1000"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "`milliether` must be a positive integer"})
+		return
+	}
+	md, ok := s.Watcher.LatestMarketData(c.Param("id"))
+	if !ok {
+		c.JSON(http.StatusNotFound, gin.H{"error": "market not found"})
+		return
+	}
+	trace, err := markets.GetLiquidityTrace(s.Watcher.LiquidityCalculator, md, s.Watcher.Liquidity, currency.Milliether(milliether).Ether())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	writeProto(c, trace)
+}
+
+// getMarketMaker returns the liquidity provided by an address as of the last
+// processed block
+func (s *Server) getMarketMaker(c *gin.Context) {
+	maker, ok := s.Watcher.MarketMakers.Lookup(c.Param("address"))
+	if !ok {
+		c.JSON(http.StatusNotFound, gin.H{"error": "address has no open orders"})
+		return
+	}
+	writeProto(c, maker)
+}
+
+// writeProto writes a proto message as JSON using the original proto field names
+func writeProto(c *gin.Context, msg proto.Message) {
+	marshaler := jsonpb.Marshaler{OrigName: true}
+	body, err := marshaler.MarshalToString(msg)
+	if err != nil {
+		logrus.WithError(err).Errorf("Failed to marshal HTTP response")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to marshal response"})
+		return
+	}
+	c.Data(http.StatusOK, "application/json; charset=utf-8", []byte(body))
+}
@@ -14,4 +14,32 @@ const (
 	GCloudProjectID              = "GCLOUD_PROJECT_ID"
 	GCloudStorageBucket          = "GCLOUD_STORAGE_BUCKET"
 	DebugMarkets                 = "DEBUG_MARKETS"
+
+	MarketEventPredictionThreshold = "MARKET_EVENT_PREDICTION_THRESHOLD"
+
+	DataDir        = "DATA_DIR"
+	WebhooksConfig = "WEBHOOKS_CONFIG"
+
+	AlertRulesConfig = "ALERT_RULES_CONFIG"
+
+	TimeSeriesRawRetention = "TIMESERIES_RAW_RETENTION"
+	TimeSeriesRetention    = "TIMESERIES_RETENTION"
+
+	PredictionMethod           = "PREDICTION_METHOD"
+	PredictionWindow           = "PREDICTION_WINDOW"
+	PredictionBlendTradeWeight = "PREDICTION_BLEND_TRADE_WEIGHT"
+
+	LiquidityConfig             = "LIQUIDITY_CONFIG"
+	LiquidityMillietherTranches = "LIQUIDITY_MILLIETHER_TRANCHES"
+	LiquidityUSDTranches        = "LIQUIDITY_USD_TRANCHES"
+	LiquidityFees               = "LIQUIDITY_FEES"
+	GasPerFill                  = "GAS_PER_FILL"
+
+	// Deprecated, only read to warn that it is ignored
+	LiquiditySellingIncrement = "LIQUIDITY_SELLING_INCREMENT"
+
+	PriceImpactShareLadder = "PRICE_IMPACT_SHARE_LADDER"
+	PriceImpactEtherLadder = "PRICE_IMPACT_ETHER_LADDER"
+
+	Workers = "WORKERS"
 )
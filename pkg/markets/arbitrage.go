@@ -0,0 +1,210 @@
+package markets
+
+import (
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/stateshape/augur-analyzer/pkg/markets/liquidity"
+	"github.com/stateshape/augur-analyzer/pkg/proto/markets"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Amounts below this are treated as fully taken when walking order books
+const arbitrageAmountEpsilon = 1e-9
+
+type arbitrageLevel struct {
+	Price  float64
+	Amount float64
+}
+
+// GetArbitrageOpportunities walks the order books of a market, in the order
+// returned by getOutcomeOrderBooks, for complete set arbitrage in categorical
+// markets and crossed books in all markets
+func GetArbitrageOpportunities(marketID string, marketType markets.MarketType, books []liquidity.OutcomeOrderBook, outcomeIDs []uint64, minPrice, maxPrice float64) []*markets.ArbitrageOpportunity {
+	opportunities := []*markets.ArbitrageOpportunity{}
+	priceRange := maxPrice - minPrice
+	if priceRange <= 0 || len(books) == 0 {
+		return opportunities
+	}
+
+	bidsByBook, asksByBook := [][]*arbitrageLevel{}, [][]*arbitrageLevel{}
+	for _, book := range books {
+		bids, asks := book.Levels()
+		bidsByBook = append(bidsByBook, toArbitrageLevels(bids))
+		asksByBook = append(asksByBook, toArbitrageLevels(asks))
+	}
+
+	for i, book := range books {
+		bids, asks := book.Levels()
+		size, profit, first, ok := walkCrossedBook(toArbitrageLevels(bids), toArbitrageLevels(asks))
+		if !ok {
+			continue
+		}
+		opportunities = append(opportunities, &markets.ArbitrageOpportunity{
+			Type:          markets.ArbitrageType_CROSSED_BOOK,
+			MarketId:      marketID,
+			OutcomeId:     outcomeIDs[i],
+			Size:          float32(size),
+			MaxProfit:     float32(profit),
+			ProfitPerUnit: float32(first),
+		})
+	}
+
+	if marketType != markets.MarketType_CATEGORICAL {
+		return opportunities
+	}
+
+	// Buy one share of every outcome and redeem the complete set
+	size, profit, first := walkCompleteSets(asksByBook, func(prices []float64) float64 {
+		cost := 0.0
+		for _, price := range prices {
+			cost += price - minPrice
+		}
+		return priceRange - cost
+	})
+	if size > 0 {
+		opportunities = append(opportunities, &markets.ArbitrageOpportunity{
+			Type:          markets.ArbitrageType_ASKS_BELOW_COMPLETE_SET,
+			MarketId:      marketID,
+			Size:          float32(size),
+			MaxProfit:     float32(profit),
+			ProfitPerUnit: float32(first),
+		})
+	}
+
+	// Buy a complete set and sell one share of every outcome
+	size, profit, first = walkCompleteSets(bidsByBook, func(prices []float64) float64 {
+		proceeds := 0.0
+		for _, price := range prices {
+			proceeds += price - minPrice
+		}
+		return proceeds - priceRange
+	})
+	if size > 0 {
+		opportunities = append(opportunities, &markets.ArbitrageOpportunity{
+			Type:          markets.ArbitrageType_BIDS_ABOVE_COMPLETE_SET,
+			MarketId:      marketID,
+			Size:          float32(size),
+			MaxProfit:     float32(profit),
+			ProfitPerUnit: float32(first),
+		})
+	}
+	return opportunities
+}
+
+// walkCompleteSets takes the best level of every book while trading a
+// complete set at those prices is profitable. It returns the number of
+// complete sets, the total profit and the profit of the first set.
+func walkCompleteSets(books [][]*arbitrageLevel, profitPerSet func(prices []float64) float64) (float64, float64, float64) {
+	size, profit, first := 0.0, 0.0, 0.0
+	for {
+		prices := []float64{}
+		unit := math.Inf(1)
+		for _, book := range books {
+			if len(book) == 0 {
+				return size, profit, first
+			}
+			prices = append(prices, book[0].Price)
+			unit = math.Min(unit, book[0].Amount)
+		}
+		p := profitPerSet(prices)
+		if p <= 0 {
+			return size, profit, first
+		}
+		if size == 0 {
+			first = p
+		}
+		size += unit
+		profit += unit * p
+		for i := range books {
+			books[i][0].Amount -= unit
+			if books[i][0].Amount <= arbitrageAmountEpsilon {
+				books[i] = books[i][1:]
+			}
+		}
+	}
+}
+
+// walkCrossedBook buys the asks and sells into the bids of an outcome while
+// the best bid is at or above the best ask. Returns false if the book is not
+// crossed.
+func walkCrossedBook(bids, asks []*arbitrageLevel) (float64, float64, float64, bool) {
+	size, profit, first := 0.0, 0.0, 0.0
+	crossed := false
+	for len(bids) > 0 && len(asks) > 0 && bids[0].Price >= asks[0].Price {
+		p := bids[0].Price - asks[0].Price
+		unit := math.Min(bids[0].Amount, asks[0].Amount)
+		if !crossed {
+			first = p
+			crossed = true
+		}
+		size += unit
+		profit += unit * p
+		bids[0].Amount -= unit
+		asks[0].Amount -= unit
+		if bids[0].Amount <= arbitrageAmountEpsilon {
+			bids = bids[1:]
+		}
+		if asks[0].Amount <= arbitrageAmountEpsilon {
+			asks = asks[1:]
+		}
+	}
+	return size, profit, first, crossed
+}
+
+func toArbitrageLevels(laps []*markets.LiquidityAtPrice) []*arbitrageLevel {
+	levels := []*arbitrageLevel{}
+	for _, lap := range laps {
+		if lap.Amount <= 0 {
+			continue
+		}
+		levels = append(levels, &arbitrageLevel{Price: float64(lap.Price), Amount: float64(lap.Amount)})
+	}
+	return levels
+}
+
+// deriveArbitrageOpportunities collects the arbitrage opportunities of all
+// markets, most profitable first
+func deriveArbitrageOpportunities(ms []*markets.Market, msd *MarketsData) []*markets.ArbitrageOpportunity {
+	opportunities := []*markets.ArbitrageOpportunity{}
+	for _, m := range ms {
+		md, ok := msd.ByMarketID[m.Id]
+		if !ok || md.Info == nil {
+			continue
+		}
+		minPrice, err := strconv.ParseFloat(md.Info.MinPrice, 64)
+		if err != nil {
+			logrus.WithField("marketAddress", m.Id).WithError(err).Errorf("Failed to parse market min price")
+			continue
+		}
+		maxPrice, err := strconv.ParseFloat(md.Info.MaxPrice, 64)
+		if err != nil {
+			logrus.WithField("marketAddress", m.Id).WithError(err).Errorf("Failed to parse market max price")
+			continue
+		}
+		bids, err := GetBidLevels(md.Orders)
+		if err != nil {
+			logrus.WithField("marketAddress", m.Id).WithError(err).Errorf("Failed to get bids by outcome")
+			continue
+		}
+		asks, err := GetAskLevels(md.Orders)
+		if err != nil {
+			logrus.WithField("marketAddress", m.Id).WithError(err).Errorf("Failed to get asks by outcome")
+			continue
+		}
+		books, outcomeIDs := getOutcomeOrderBooks(md.Info, bids, asks)
+		opportunities = append(opportunities, GetArbitrageOpportunities(m.Id, m.MarketType, books, outcomeIDs, minPrice, maxPrice)...)
+	}
+	sort.SliceStable(opportunities, func(i, j int) bool {
+		if opportunities[i].MaxProfit != opportunities[j].MaxProfit {
+			return opportunities[i].MaxProfit > opportunities[j].MaxProfit
+		}
+		if opportunities[i].MarketId != opportunities[j].MarketId {
+			return opportunities[i].MarketId < opportunities[j].MarketId
+		}
+		return opportunities[i].Type < opportunities[j].Type
+	})
+	return opportunities
+}
@@ -0,0 +1,72 @@
+package markets_test
+
+import (
+	"testing"
+
+	"github.com/stateshape/augur-analyzer/pkg/markets"
+	"github.com/stateshape/augur-analyzer/pkg/markets/liquidity"
+	protomarkets "github.com/stateshape/augur-analyzer/pkg/proto/markets"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetArbitrageOpportunities(t *testing.T) {
+	laps := func(pricesAndAmounts ...float32) []*protomarkets.LiquidityAtPrice {
+		list := []*protomarkets.LiquidityAtPrice{}
+		for i := 0; i < len(pricesAndAmounts); i += 2 {
+			list = append(list, &protomarkets.LiquidityAtPrice{Price: pricesAndAmounts[i], Amount: pricesAndAmounts[i+1]})
+		}
+		return list
+	}
+
+	t.Run("Asks below complete set", func(t *testing.T) {
+		books := []liquidity.OutcomeOrderBook{
+			liquidity.NewOutcomeOrderBook(laps(), laps(0.25, 4, 0.4, 10)),
+			liquidity.NewOutcomeOrderBook(laps(), laps(0.25, 10)),
+			liquidity.NewOutcomeOrderBook(laps(), laps(0.25, 10)),
+		}
+		opportunities := markets.GetArbitrageOpportunities("m", protomarkets.MarketType_CATEGORICAL, books, []uint64{0, 1, 2}, 0, 1)
+		assert.Equal(t, 1, len(opportunities))
+		o := opportunities[0]
+		assert.Equal(t, protomarkets.ArbitrageType_ASKS_BELOW_COMPLETE_SET, o.Type)
+		// 4 sets at 0.75 and 6 sets at 0.9
+		assert.InDelta(t, 10, o.Size, 1e-5)
+		assert.InDelta(t, 4*0.25+6*0.1, o.MaxProfit, 1e-5)
+		assert.InDelta(t, 0.25, o.ProfitPerUnit, 1e-6)
+	})
+
+	t.Run("Bids above complete set", func(t *testing.T) {
+		books := []liquidity.OutcomeOrderBook{
+			liquidity.NewOutcomeOrderBook(laps(0.6, 2), laps()),
+			liquidity.NewOutcomeOrderBook(laps(0.5, 5), laps()),
+		}
+		opportunities := markets.GetArbitrageOpportunities("m", protomarkets.MarketType_CATEGORICAL, books, []uint64{0, 1}, 0, 1)
+		assert.Equal(t, 1, len(opportunities))
+		assert.Equal(t, protomarkets.ArbitrageType_BIDS_ABOVE_COMPLETE_SET, opportunities[0].Type)
+		assert.InDelta(t, 2, opportunities[0].Size, 1e-6)
+		assert.InDelta(t, 0.2, opportunities[0].MaxProfit, 1e-5)
+	})
+
+	t.Run("Crossed scalar book", func(t *testing.T) {
+		books := []liquidity.OutcomeOrderBook{
+			liquidity.NewOutcomeOrderBook(laps(210, 1, 200, 3, 150, 5), laps(200, 2, 220, 5)),
+		}
+		opportunities := markets.GetArbitrageOpportunities("m", protomarkets.MarketType_SCALAR, books, []uint64{1}, 100, 300)
+		assert.Equal(t, 1, len(opportunities))
+		o := opportunities[0]
+		assert.Equal(t, protomarkets.ArbitrageType_CROSSED_BOOK, o.Type)
+		assert.Equal(t, uint64(1), o.OutcomeId)
+		assert.InDelta(t, 2, o.Size, 1e-6)
+		assert.InDelta(t, 10, o.MaxProfit, 1e-5)
+		assert.InDelta(t, 10, o.ProfitPerUnit, 1e-5)
+	})
+
+	t.Run("Healthy book", func(t *testing.T) {
+		books := []liquidity.OutcomeOrderBook{
+			liquidity.NewOutcomeOrderBook(laps(0.3, 1), laps(0.4, 1)),
+			liquidity.NewOutcomeOrderBook(laps(0.6, 1), laps(0.7, 1)),
+		}
+		opportunities := markets.GetArbitrageOpportunities("m", protomarkets.MarketType_CATEGORICAL, books, []uint64{0, 1}, 0, 1)
+		assert.Equal(t, 0, len(opportunities))
+	})
+}
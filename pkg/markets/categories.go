@@ -0,0 +1,144 @@
+package markets
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/stateshape/augur-analyzer/pkg/proto/markets"
+
+	"github.com/sirupsen/logrus"
+)
+
+type categoryAccumulator struct {
+	statistics *markets.CategoryStatistics
+
+	// Sums and counts used to average the retention ratios
+	retentionRatioSums   map[uint64]float64
+	retentionRatioCounts map[uint64]int
+}
+
+// GetCategoryStatistics aggregates the translated markets by category and by
+// tag. Markets without a category or tags are left out of the respective
+// list. Both lists are ordered from the most to the least active.
+func GetCategoryStatistics(ms []*markets.Market, msd *MarketsData) ([]*markets.CategoryStatistics, []*markets.CategoryStatistics) {
+	categories := map[string]*categoryAccumulator{}
+	tags := map[string]*categoryAccumulator{}
+
+	for _, m := range ms {
+		var md *MarketData
+		if msd != nil {
+			md = msd.ByMarketID[m.Id]
+		}
+
+		if m.Category != "" {
+			accumulateCategoryStatistics(categories, m.Category, m, md)
+		}
+		// Count markets repeating a tag only once
+		seen := map[string]struct{}{}
+		for _, tag := range m.Tags {
+			if _, ok := seen[tag]; ok || tag == "" {
+				continue
+			}
+			seen[tag] = struct{}{}
+			accumulateCategoryStatistics(tags, tag, m, md)
+		}
+	}
+
+	return finalizeCategoryStatistics(categories), finalizeCategoryStatistics(tags)
+}
+
+func accumulateCategoryStatistics(accumulators map[string]*categoryAccumulator, name string, m *markets.Market, md *MarketData) {
+	acc, ok := accumulators[name]
+	if !ok {
+		acc = &categoryAccumulator{
+			statistics: &markets.CategoryStatistics{
+				Name:                                     name,
+				TotalMarketsCapitalization:               &markets.Price{},
+				TotalVolume:                              &markets.Price{},
+				TotalVolumeLastDay:                       &markets.Price{},
+				OpenInterest:                             &markets.Price{},
+				AverageRetentionRatioByMillietherTranche: map[uint64]float32{},
+				TotalMarketsByReportingState:             map[string]uint64{},
+			},
+			retentionRatioSums:   map[uint64]float64{},
+			retentionRatioCounts: map[uint64]int{},
+		}
+		accumulators[name] = acc
+	}
+
+	stats := acc.statistics
+	stats.TotalMarkets++
+	stats.TrendingScore += m.TrendingScore
+	addPrice(stats.TotalMarketsCapitalization, m.MarketCapitalization)
+	addPrice(stats.TotalVolume, m.Volume)
+	addPrice(stats.TotalVolumeLastDay, m.VolumeLastDay)
+	if m.LiquidityMetrics != nil {
+		for tranche, rr := range m.LiquidityMetrics.RetentionRatioByMillietherTranche {
+			acc.retentionRatioSums[tranche] += float64(rr)
+			acc.retentionRatioCounts[tranche]++
+		}
+	}
+
+	if md == nil || md.Info == nil {
+		return
+	}
+	stats.TotalMarketsByReportingState[md.Info.ReportingState.String()]++
+	priceRange, err := getPriceRange(md)
+	if err != nil {
+		logrus.WithError(err).
+			WithField("marketId", m.Id).
+			Errorf("Failed to derive open interest for category statistics")
+		return
+	}
+	if m.MarketCapitalization == nil {
+		return
+	}
+	// Each outstanding complete set locks up the full price range of the market
+	addPrice(stats.OpenInterest, &markets.Price{
+		Eth: m.MarketCapitalization.Eth * float32(priceRange),
+		Usd: m.MarketCapitalization.Usd * float32(priceRange),
+		Btc: m.MarketCapitalization.Btc * float32(priceRange),
+	})
+}
+
+func finalizeCategoryStatistics(accumulators map[string]*categoryAccumulator) []*markets.CategoryStatistics {
+	statistics := []*markets.CategoryStatistics{}
+	for _, acc := range accumulators {
+		for tranche, sum := range acc.retentionRatioSums {
+			acc.statistics.AverageRetentionRatioByMillietherTranche[tranche] = float32(sum / float64(acc.retentionRatioCounts[tranche]))
+		}
+		statistics = append(statistics, acc.statistics)
+	}
+	sort.Slice(statistics, func(i, j int) bool {
+		a, b := statistics[i], statistics[j]
+		if a.TrendingScore != b.TrendingScore {
+			return a.TrendingScore > b.TrendingScore
+		}
+		if a.TotalMarkets != b.TotalMarkets {
+			return a.TotalMarkets > b.TotalMarkets
+		}
+		return a.Name < b.Name
+	})
+	return statistics
+}
+
+func getPriceRange(md *MarketData) (float64, error) {
+	minPrice, err := strconv.ParseFloat(md.Info.MinPrice, 64)
+	if err != nil {
+		return 0, err
+	}
+	maxPrice, err := strconv.ParseFloat(md.Info.MaxPrice, 64)
+	if err != nil {
+		return 0, err
+	}
+	return maxPrice - minPrice, nil
+}
+
+func addPrice(total, price *markets.Price) {
+	if price == nil {
+		return
+	}
+	total.Eth += price.Eth
+	total.Usd += price.Usd
+	total.Btc += price.Btc
+}
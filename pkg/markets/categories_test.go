@@ -0,0 +1,71 @@
+package markets_test
+
+import (
+	"testing"
+
+	"github.com/stateshape/augur-analyzer/pkg/markets"
+	"github.com/stateshape/augur-analyzer/pkg/proto/augur"
+	protomarkets "github.com/stateshape/augur-analyzer/pkg/proto/markets"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetCategoryStatistics(t *testing.T) {
+	market := func(id, category string, tags []string, capitalization, trendingScore float32, rr float32) *protomarkets.Market {
+		return &protomarkets.Market{
+			Id:                   id,
+			Category:             category,
+			Tags:                 tags,
+			MarketCapitalization: &protomarkets.Price{Eth: capitalization},
+			Volume:               &protomarkets.Price{Eth: 1},
+			TrendingScore:        trendingScore,
+			LiquidityMetrics: &protomarkets.LiquidityMetrics{
+				RetentionRatioByMillietherTranche: map[uint64]float32{1000: rr},
+			},
+		}
+	}
+	info := func(id, minPrice, maxPrice string, state augur.ReportingState) *markets.MarketData {
+		return &markets.MarketData{
+			Info: &augur.MarketInfo{
+				Id:             id,
+				MinPrice:       minPrice,
+				MaxPrice:       maxPrice,
+				ReportingState: state,
+			},
+		}
+	}
+
+	ms := []*protomarkets.Market{
+		market("a", "SPORTS", []string{"soccer", "soccer"}, 2, 1, 0.5),
+		market("b", "SPORTS", []string{"basketball"}, 3, 2, 1),
+		market("c", "POLITICS", []string{"soccer"}, 1, 10, 0.25),
+		market("d", "", nil, 5, 0, 0),
+	}
+	msd := &markets.MarketsData{
+		ByMarketID: map[string]*markets.MarketData{
+			"a": info("a", "0", "1", augur.ReportingState_PRE_REPORTING),
+			"b": info("b", "0", "1", augur.ReportingState_FINALIZED),
+			"c": info("c", "-10", "10", augur.ReportingState_PRE_REPORTING),
+		},
+	}
+
+	categories, tags := markets.GetCategoryStatistics(ms, msd)
+
+	assert.Equal(t, 2, len(categories))
+	assert.Equal(t, "POLITICS", categories[0].Name)
+	assert.Equal(t, float32(20), categories[0].OpenInterest.Eth)
+
+	sports := categories[1]
+	assert.Equal(t, "SPORTS", sports.Name)
+	assert.Equal(t, uint64(2), sports.TotalMarkets)
+	assert.Equal(t, float32(5), sports.TotalMarketsCapitalization.Eth)
+	assert.Equal(t, float32(2), sports.TotalVolume.Eth)
+	assert.Equal(t, float32(0.75), sports.AverageRetentionRatioByMillietherTranche[1000])
+	assert.Equal(t, uint64(1), sports.TotalMarketsByReportingState[augur.ReportingState_PRE_REPORTING.String()])
+	assert.Equal(t, uint64(1), sports.TotalMarketsByReportingState[augur.ReportingState_FINALIZED.String()])
+
+	assert.Equal(t, 2, len(tags))
+	assert.Equal(t, "soccer", tags[0].Name)
+	assert.Equal(t, uint64(2), tags[0].TotalMarkets)
+	assert.Equal(t, "basketball", tags[1].Name)
+}
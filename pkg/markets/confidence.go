@@ -0,0 +1,126 @@
+package markets
+
+import (
+	"math"
+	"time"
+
+	"github.com/stateshape/augur-analyzer/pkg/proto/markets"
+)
+
+const (
+	// ETH taken from each side of the book for the depth weighted prices.
+	// Liquidity missing to fill it is priced at the bound of the market.
+	confidenceReferenceEther = 1.0
+
+	// Trades older than this widen the band by the full staleness widening
+	confidenceStaleTradeAge = 7 * 24 * time.Hour
+	// Fraction of the price range added on each side of a stale prediction
+	confidenceStaleWidening = 0.25
+
+	// Maximum width of the band, as a fraction of the price range, per grade
+	confidenceHighMaxWidth   = 0.1
+	confidenceMediumMaxWidth = 0.3
+)
+
+// ApplyPredictionConfidence sets the uncertainty band and the confidence
+// grade of the predictions of a market
+func ApplyPredictionConfidence(predictions []*markets.Prediction, marketType markets.MarketType, bids, asks map[uint64]*markets.ListLiquidityAtPrice, minPrice, maxPrice float64, lastTradeTime uint64, now time.Time) {
+	priceRange := maxPrice - minPrice
+	if priceRange <= 0 {
+		return
+	}
+
+	staleness := 1.0
+	if lastTradeTime > 0 {
+		age := now.Sub(time.Unix(int64(lastTradeTime), 0))
+		staleness = math.Max(0, math.Min(1, float64(age)/float64(confidenceStaleTradeAge)))
+	}
+
+	for _, prediction := range predictions {
+		var lower, upper float64
+		if marketType == markets.MarketType_CATEGORICAL {
+			lower, upper = getDepthWeightedBand(levels(bids[prediction.OutcomeId]), levels(asks[prediction.OutcomeId]), minPrice, maxPrice)
+		} else {
+			lower, upper = getDepthWeightedBand(levels(bids[1]), levels(asks[1]), minPrice, maxPrice)
+			// Mirror the book of the lower outcome if the upper outcome has no orders
+			if len(levels(bids[1])) == 0 && len(levels(asks[1])) == 0 {
+				lowerOutcomeLower, lowerOutcomeUpper := getDepthWeightedBand(levels(bids[0]), levels(asks[0]), minPrice, maxPrice)
+				lower, upper = maxPrice+minPrice-lowerOutcomeUpper, maxPrice+minPrice-lowerOutcomeLower
+			}
+		}
+
+		// Percents are expressed in the price range, values are prices
+		center := float64(prediction.Value)
+		if marketType != markets.MarketType_SCALAR {
+			center = minPrice + float64(prediction.Percent)/100*priceRange
+		}
+		if isTradeBasedPrediction(prediction.Method) {
+			widening := staleness * confidenceStaleWidening * priceRange
+			lower = math.Min(lower, center-widening)
+			upper = math.Max(upper, center+widening)
+		}
+		lower = math.Max(minPrice, math.Min(lower, center))
+		upper = math.Min(maxPrice, math.Max(upper, center))
+
+		width := (upper - lower) / priceRange
+		switch {
+		case width <= confidenceHighMaxWidth:
+			prediction.Confidence = markets.PredictionConfidence_CONFIDENCE_HIGH
+		case width <= confidenceMediumMaxWidth:
+			prediction.Confidence = markets.PredictionConfidence_CONFIDENCE_MEDIUM
+		default:
+			prediction.Confidence = markets.PredictionConfidence_CONFIDENCE_LOW
+		}
+
+		if marketType == markets.MarketType_SCALAR {
+			prediction.LowerBound = float32(lower)
+			prediction.UpperBound = float32(upper)
+			continue
+		}
+		prediction.LowerBound = float32((lower - minPrice) / priceRange * 100)
+		prediction.UpperBound = float32((upper - minPrice) / priceRange * 100)
+	}
+}
+
+// isTradeBasedPrediction is false for the methods which do not use the trades
+// of the market, every other method falls back to the last traded price
+func isTradeBasedPrediction(method markets.PredictionMethod) bool {
+	switch method {
+	case markets.PredictionMethod_ORDER_BOOK_MIDPOINT, markets.PredictionMethod_INVALID_ESTIMATE:
+		return false
+	}
+	return true
+}
+
+// getDepthWeightedBand returns the average price of selling and of buying
+// confidenceReferenceEther worth of shares against the book
+func getDepthWeightedBand(bids, asks []*markets.LiquidityAtPrice, minPrice, maxPrice float64) (float64, float64) {
+	return getDepthWeightedPrice(bids, minPrice, maxPrice, minPrice), getDepthWeightedPrice(asks, minPrice, maxPrice, maxPrice)
+}
+
+// getDepthWeightedPrice walks the levels, best first, until the shares taken
+// are worth confidenceReferenceEther and prices the remainder at the bound
+func getDepthWeightedPrice(laps []*markets.LiquidityAtPrice, minPrice, maxPrice, bound float64) float64 {
+	remaining := confidenceReferenceEther
+	shares, total := 0.0, 0.0
+	for _, lap := range laps {
+		if remaining <= 0 {
+			break
+		}
+		price := float64(lap.Price)
+		// Value a share by the larger of the escrows of its buyer and
+		// seller so levels close to either bound count as well
+		value := math.Max(price-minPrice, maxPrice-price)
+		taken := math.Min(float64(lap.Amount), remaining/value)
+		shares += taken
+		total += taken * price
+		remaining -= taken * value
+	}
+	if remaining > 0 {
+		value := math.Max(bound-minPrice, maxPrice-bound)
+		taken := remaining / value
+		shares += taken
+		total += taken * bound
+	}
+	return total / shares
+}
@@ -0,0 +1,65 @@
+package markets_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stateshape/augur-analyzer/pkg/markets"
+	protomarkets "github.com/stateshape/augur-analyzer/pkg/proto/markets"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyPredictionConfidence(t *testing.T) {
+	list := func(price, amount float32) map[uint64]*protomarkets.ListLiquidityAtPrice {
+		return map[uint64]*protomarkets.ListLiquidityAtPrice{
+			1: {LiquidityAtPrice: []*protomarkets.LiquidityAtPrice{{Price: price, Amount: amount}}},
+		}
+	}
+	now := time.Unix(1000000, 0)
+
+	t.Run("Tight liquid book", func(t *testing.T) {
+		predictions := []*protomarkets.Prediction{{OutcomeId: 1, Percent: 50, Method: protomarkets.PredictionMethod_ORDER_BOOK_MIDPOINT}}
+		markets.ApplyPredictionConfidence(predictions, protomarkets.MarketType_YESNO, list(0.49, 100), list(0.51, 100), 0, 1, 0, now)
+		assert.InDelta(t, 49, predictions[0].LowerBound, 1e-4)
+		assert.InDelta(t, 51, predictions[0].UpperBound, 1e-4)
+		assert.Equal(t, protomarkets.PredictionConfidence_CONFIDENCE_HIGH, predictions[0].Confidence)
+	})
+
+	t.Run("Wide book", func(t *testing.T) {
+		predictions := []*protomarkets.Prediction{{OutcomeId: 1, Percent: 50, Method: protomarkets.PredictionMethod_ORDER_BOOK_MIDPOINT}}
+		markets.ApplyPredictionConfidence(predictions, protomarkets.MarketType_YESNO, list(0.01, 100), list(0.99, 100), 0, 1, 0, now)
+		assert.InDelta(t, 1, predictions[0].LowerBound, 1e-4)
+		assert.InDelta(t, 99, predictions[0].UpperBound, 1e-4)
+		assert.Equal(t, protomarkets.PredictionConfidence_CONFIDENCE_LOW, predictions[0].Confidence)
+	})
+
+	t.Run("Thin book", func(t *testing.T) {
+		// One share worth half an ETH at the best bid, the
+		// other half ETH buys half a share at the minimum
+		predictions := []*protomarkets.Prediction{{OutcomeId: 1, Percent: 50, Method: protomarkets.PredictionMethod_ORDER_BOOK_MIDPOINT}}
+		markets.ApplyPredictionConfidence(predictions, protomarkets.MarketType_YESNO, list(0.5, 1), list(0.52, 100), 0, 1, 0, now)
+		assert.InDelta(t, 100.0/3, predictions[0].LowerBound, 1e-4)
+		assert.Equal(t, protomarkets.PredictionConfidence_CONFIDENCE_MEDIUM, predictions[0].Confidence)
+	})
+
+	t.Run("Stale scalar trade", func(t *testing.T) {
+		predictions := []*protomarkets.Prediction{{OutcomeId: 1, Value: 200, Method: protomarkets.PredictionMethod_LAST_TRADE_PRICE}}
+		lastTrade := uint64(now.Add(-14 * 24 * time.Hour).Unix())
+		markets.ApplyPredictionConfidence(predictions, protomarkets.MarketType_SCALAR, list(199, 100), list(201, 100), 100, 300, lastTrade, now)
+		assert.InDelta(t, 150, predictions[0].LowerBound, 1e-3)
+		assert.InDelta(t, 250, predictions[0].UpperBound, 1e-3)
+		assert.Equal(t, protomarkets.PredictionConfidence_CONFIDENCE_LOW, predictions[0].Confidence)
+	})
+
+	t.Run("Stale trade with a weighted method", func(t *testing.T) {
+		// The weighted price falls back to the last traded price when no
+		// trade is in the window
+		predictions := []*protomarkets.Prediction{{OutcomeId: 1, Percent: 50, Method: protomarkets.PredictionMethod_VWAP}}
+		lastTrade := uint64(now.Add(-90 * 24 * time.Hour).Unix())
+		markets.ApplyPredictionConfidence(predictions, protomarkets.MarketType_YESNO, list(0.49, 100), list(0.51, 100), 0, 1, lastTrade, now)
+		assert.InDelta(t, 25, predictions[0].LowerBound, 1e-4)
+		assert.InDelta(t, 75, predictions[0].UpperBound, 1e-4)
+		assert.Equal(t, protomarkets.PredictionConfidence_CONFIDENCE_LOW, predictions[0].Confidence)
+	})
+}
@@ -5,14 +5,16 @@ import (
 	"runtime/debug"
 	"strings"
 
+	"github.com/stateshape/augur-analyzer/pkg/currency"
 	"github.com/stateshape/augur-analyzer/pkg/env"
+	"github.com/stateshape/augur-analyzer/pkg/markets/liquidity"
 	"github.com/stateshape/augur-analyzer/pkg/proto/markets"
 
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 )
 
-func DebugMarkets(inputMarketsData *MarketsData, outputMarketsData []*markets.Market) {
+func DebugMarkets(inputMarketsData *MarketsData, outputMarketsData []*markets.Market, calculator liquidity.Calculator, config *LiquidityConfig) {
 	// Catch all panics
 	defer func() {
 		if err := recover(); err != nil {
@@ -51,6 +53,7 @@ func DebugMarkets(inputMarketsData *MarketsData, outputMarketsData []*markets.Ma
 			logrus.WithField("marketId", mid).Warnf("Input market data no found for market while debugging")
 		} else {
 			printInputMarketData(mid, inputMarketData)
+			printLiquidityTraces(mid, inputMarketData, calculator, config)
 		}
 
 		outputMarketData, ok := outputMarketsDataByID[mid]
@@ -131,6 +134,55 @@ func printInputMarketData(id string, data *MarketData) {
 	}
 }
 
+func printLiquidityTraces(id string, data *MarketData, calculator liquidity.Calculator, config *LiquidityConfig) {
+	if data.Info == nil {
+		return
+	}
+	marketType, err := getMarketType(data.Info)
+	if err != nil {
+		logrus.WithField("marketId", id).WithError(err).Warnf("Failed to get market type while tracing liquidity")
+		return
+	}
+	for _, tranche := range config.TranchesFor(marketType).MillietherTranches {
+		trace, err := GetLiquidityTrace(calculator, data, config, currency.Milliether(tranche).Ether())
+		if err != nil {
+			logrus.WithField("marketId", id).WithError(err).Warnf("Failed to trace liquidity retention")
+			return
+		}
+		logrus.WithFields(logrus.Fields{
+			"marketId":       id,
+			"tranche":        tranche,
+			"completeSets":   trace.CompleteSets,
+			"proceeds":       trace.Proceeds,
+			"retentionRatio": trace.RetentionRatio,
+		}).Warnf("Liquidity retention trace")
+		for i, increment := range trace.Increments {
+			logrus.WithFields(logrus.Fields{
+				"marketId":              id,
+				"tranche":               tranche,
+				"index":                 i,
+				"shares":                increment.Shares,
+				"strategy":              increment.Strategy,
+				"outcomeId":             increment.OutcomeId,
+				"proceeds":              increment.Proceeds,
+				"remainingCompleteSets": increment.RemainingCompleteSets,
+			}).Warnf("Liquidity retention increment")
+			for _, fill := range increment.Fills {
+				logrus.WithFields(logrus.Fields{
+					"marketId":     id,
+					"tranche":      tranche,
+					"index":        i,
+					"outcomeId":    fill.OutcomeId,
+					"closingShort": fill.ClosingShort,
+					"shares":       fill.Shares,
+					"proceeds":     fill.Proceeds,
+					"levels":       fmt.Sprintf("%v", fill.Levels),
+				}).Warnf("Liquidity retention fill")
+			}
+		}
+	}
+}
+
 func printOutputMarketData(data *markets.Market) {
 	logrus.WithField("marketId", data.Id).Warnf("Output data for market")
 
@@ -0,0 +1,159 @@
+package markets
+
+import (
+	"math"
+	"time"
+
+	"github.com/stateshape/augur-analyzer/pkg/proto/augur"
+	"github.com/stateshape/augur-analyzer/pkg/proto/markets"
+)
+
+const (
+	// Number of equal width bins of the histogram of scalar distributions
+	ScalarDistributionBins = 10
+
+	// Trades older than this are left out of scalar distributions
+	scalarDistributionTradeWindow = 7 * 24 * time.Hour
+
+	// Smallest standard deviation of scalar distributions, as a fraction of
+	// the range of the market, so that a locked book does not collapse the
+	// distribution to a single value
+	scalarDistributionMinDeviation = 0.01
+)
+
+// Percentiles of the values published in scalar distributions
+var ScalarDistributionPercentiles = []uint32{10, 25, 50, 75, 90}
+
+// GetScalarDistribution derives the distribution of the resolution value of a
+// scalar market from the prices of both outcomes. Only the best bid and ask
+// are used from the books, so deep orders away from them do not move it, and
+// the amounts of the trades only weigh their prices.
+//
+// Prices of the lower outcome are mirrored into prices of the upper outcome,
+// and the best bid and ask of the two books are combined. The distribution is
+// a normal distribution truncated to [minPrice, maxPrice]:
+//   - its mean is the mid of the best bid and ask. Without both, it is the
+//     volume weighted average price of the recent trades, kept between the
+//     best bid or ask left, or else the mid with the missing side at the bound
+//     of the range.
+//   - its variance is the square of half the spread, with a missing side at
+//     the bound of the range and none without a book, plus the variance of
+//     the recent trade prices around their average, with a floor of
+//     scalarDistributionMinDeviation.
+//
+// Returns nil if the market has neither orders nor recent trades.
+func GetScalarDistribution(denomination string, bids, asks map[uint64]*markets.ListLiquidityAtPrice, history *augur.MarketPriceHistory, minPrice, maxPrice float64, now time.Time) (*markets.ScalarDistribution, error) {
+	if maxPrice <= minPrice {
+		return nil, nil
+	}
+	valueOf := func(outcome uint64, price float64) float64 {
+		if outcome == 0 {
+			price = maxPrice + minPrice - price
+		}
+		return math.Max(minPrice, math.Min(maxPrice, price))
+	}
+
+	// Bids of the lower outcome are asks of the upper outcome and vice versa
+	bestBid, bestAsk := math.NaN(), math.NaN()
+	for side, book := range []map[uint64]*markets.ListLiquidityAtPrice{bids, asks} {
+		isBid := side == 0
+		for outcome, list := range book {
+			for _, lap := range levels(list) {
+				if lap.Amount <= 0 {
+					continue
+				}
+				value := valueOf(outcome, float64(lap.Price))
+				if isBid == (outcome != 0) {
+					if math.IsNaN(bestBid) || value > bestBid {
+						bestBid = value
+					}
+				} else if math.IsNaN(bestAsk) || value < bestAsk {
+					bestAsk = value
+				}
+			}
+		}
+	}
+
+	volume, vwap, variance := 0.0, 0.0, 0.0
+	if history != nil {
+		type trade struct{ Value, Amount float64 }
+		recent := []trade{}
+		for outcome, list := range history.TimestampedPriceAmountByOutcome {
+			trades, err := parseTimestampedTrades(list)
+			if err != nil {
+				return nil, err
+			}
+			for _, t := range trades {
+				if now.Sub(t.Timestamp) > scalarDistributionTradeWindow || t.Amount <= 0 {
+					continue
+				}
+				recent = append(recent, trade{Value: valueOf(outcome, t.Price), Amount: t.Amount})
+				volume += t.Amount
+				vwap += valueOf(outcome, t.Price) * t.Amount
+			}
+		}
+		if volume > 0 {
+			vwap /= volume
+			for _, t := range recent {
+				variance += (t.Value - vwap) * (t.Value - vwap) * t.Amount / volume
+			}
+		}
+	}
+	if math.IsNaN(bestBid) && math.IsNaN(bestAsk) && volume == 0 {
+		return nil, nil
+	}
+
+	lower, upper := bestBid, bestAsk
+	if math.IsNaN(lower) {
+		lower = minPrice
+	}
+	if math.IsNaN(upper) {
+		upper = maxPrice
+	}
+	if lower > upper {
+		// A crossed book quotes no spread
+		lower, upper = (lower+upper)/2, (lower+upper)/2
+	}
+	mean := (lower + upper) / 2
+	if (math.IsNaN(bestBid) || math.IsNaN(bestAsk)) && volume > 0 {
+		mean = math.Max(lower, math.Min(upper, vwap))
+	}
+	if !math.IsNaN(bestBid) || !math.IsNaN(bestAsk) {
+		variance += (upper - lower) * (upper - lower) / 4
+	}
+	deviation := math.Max(math.Sqrt(variance), (maxPrice-minPrice)*scalarDistributionMinDeviation)
+
+	// Cumulative distribution of the normal distribution and its inverse, the
+	// probability of the truncated distribution is scaled by the probability
+	// of the range
+	cdf := func(x float64) float64 {
+		return (1 + math.Erf((x-mean)/(deviation*math.Sqrt2))) / 2
+	}
+	below, total := cdf(minPrice), cdf(maxPrice)-cdf(minPrice)
+	quantile := func(q float64) float64 {
+		x := mean + deviation*math.Sqrt2*math.Erfinv(2*(below+q*total)-1)
+		return math.Max(minPrice, math.Min(maxPrice, x))
+	}
+
+	distribution := &markets.ScalarDistribution{
+		ScalarDenomination: denomination,
+		MinPrice:           float32(minPrice),
+		MaxPrice:           float32(maxPrice),
+		ValueByPercentile:  map[uint32]float32{},
+		Histogram:          []*markets.ScalarDistributionBin{},
+	}
+	for _, percentile := range ScalarDistributionPercentiles {
+		distribution.ValueByPercentile[percentile] = float32(quantile(float64(percentile) / 100))
+	}
+
+	width := (maxPrice - minPrice) / ScalarDistributionBins
+	for i := 0; i < ScalarDistributionBins; i++ {
+		binLower, binUpper := minPrice+float64(i)*width, minPrice+float64(i+1)*width
+		distribution.Histogram = append(distribution.Histogram, &markets.ScalarDistributionBin{
+			Lower:       float32(binLower),
+			Upper:       float32(binUpper),
+			Probability: float32((cdf(binUpper) - cdf(binLower)) / total),
+		})
+	}
+	return distribution, nil
+}
@@ -0,0 +1,92 @@
+package markets_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stateshape/augur-analyzer/pkg/markets"
+	"github.com/stateshape/augur-analyzer/pkg/proto/augur"
+	protomarkets "github.com/stateshape/augur-analyzer/pkg/proto/markets"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetScalarDistribution(t *testing.T) {
+	now := time.Unix(1534000000, 0)
+	ago := func(d time.Duration) uint64 {
+		return uint64(now.Add(-d).Unix())
+	}
+	book := func(levels ...float32) *protomarkets.ListLiquidityAtPrice {
+		list := &protomarkets.ListLiquidityAtPrice{}
+		for i := 0; i < len(levels); i += 2 {
+			list.LiquidityAtPrice = append(list.LiquidityAtPrice, &protomarkets.LiquidityAtPrice{Price: levels[i], Amount: levels[i+1]})
+		}
+		return list
+	}
+
+	// Upper outcome quoted at 120 and 150, the lower outcome bid at 160
+	// mirrors to an upper ask of 140
+	bids := map[uint64]*protomarkets.ListLiquidityAtPrice{
+		0: book(160, 10),
+		1: book(120, 10),
+	}
+	asks := map[uint64]*protomarkets.ListLiquidityAtPrice{
+		1: book(150, 10),
+	}
+	history := &augur.MarketPriceHistory{
+		TimestampedPriceAmountByOutcome: map[uint64]*augur.ListTimestampedPriceAmount{
+			1: {
+				TimestampedPriceAmounts: []*augur.TimestampedPriceAmount{
+					{Price: "130", Amount: "10", Timestamp: ago(time.Hour)},
+					// Too old to be taken into account
+					{Price: "190", Amount: "1000", Timestamp: ago(30 * 24 * time.Hour)},
+				},
+			},
+		},
+	}
+
+	distribution, err := markets.GetScalarDistribution("USD", bids, asks, history, 100, 200, now)
+	assert.Nil(t, err)
+	assert.Equal(t, "USD", distribution.ScalarDenomination)
+	assert.Len(t, distribution.Histogram, markets.ScalarDistributionBins)
+
+	// Centred on 130 with a deviation of 10, truncated 3 deviations below
+	assert.InDelta(t, 130.02, distribution.ValueByPercentile[50], 0.01)
+	assert.InDelta(t, 117.26, distribution.ValueByPercentile[10], 0.01)
+	assert.InDelta(t, 142.83, distribution.ValueByPercentile[90], 0.01)
+	assert.InDelta(t, 0.3418, distribution.Histogram[2].Probability, 1e-4)
+	assert.InDelta(t, 0.3418, distribution.Histogram[3].Probability, 1e-4)
+	assert.Equal(t, float32(150), distribution.Histogram[4].Upper)
+
+	total := float32(0)
+	for _, bin := range distribution.Histogram {
+		total += bin.Probability
+	}
+	assert.InDelta(t, 1, total, 1e-6)
+
+	// Deep orders away from the best bid and ask do not move it
+	bids[1] = book(120, 10, 100, 1000)
+	asks[1] = book(150, 10, 199, 1000)
+	deep, err := markets.GetScalarDistribution("USD", bids, asks, history, 100, 200, now)
+	assert.Nil(t, err)
+	assert.Equal(t, distribution, deep)
+
+	// Without a book, centred on the average trade price with the deviation
+	// of the trade prices
+	trades, err := markets.GetScalarDistribution("USD", nil, nil, &augur.MarketPriceHistory{
+		TimestampedPriceAmountByOutcome: map[uint64]*augur.ListTimestampedPriceAmount{
+			1: {
+				TimestampedPriceAmounts: []*augur.TimestampedPriceAmount{
+					{Price: "160", Amount: "30", Timestamp: ago(time.Hour)},
+					{Price: "180", Amount: "10", Timestamp: ago(time.Hour)},
+				},
+			},
+		},
+	}, 100, 200, now)
+	assert.Nil(t, err)
+	assert.InDelta(t, 165, trades.ValueByPercentile[50], 0.01)
+
+	empty, err := markets.GetScalarDistribution("USD", nil, nil, nil, 100, 200, now)
+	assert.Nil(t, err)
+	assert.Nil(t, empty)
+}
@@ -0,0 +1,68 @@
+package markets
+
+import (
+	"math/big"
+
+	"github.com/stateshape/augur-analyzer/pkg/currency"
+	"github.com/stateshape/augur-analyzer/pkg/markets/liquidity"
+	"github.com/stateshape/augur-analyzer/pkg/proto/augur"
+	"github.com/stateshape/augur-analyzer/pkg/proto/markets"
+)
+
+// GetEntryCosts computes the cost of buying and of shorting every outcome
+// of a market at every tranche. USD tranches are skipped without a rate, and
+// gas costs without a gas price.
+func GetEntryCosts(outcomes []*augur.OutcomeInfo, bids, asks map[uint64][]*liquidity.Level, market liquidity.MarketData, tranches LiquidityTranches, ethusd float64, gas GasConfig, gasPrice *big.Int) map[uint64]*markets.OutcomeEntryCosts {
+	costsByOutcome := map[uint64]*markets.OutcomeEntryCosts{}
+	for _, outcome := range outcomes {
+		book := liquidity.NewExactOutcomeOrderBook(bids[outcome.Id], asks[outcome.Id])
+		costs := &markets.OutcomeEntryCosts{
+			BuyByMillietherTranche:   map[uint64]*markets.EntryCost{},
+			ShortByMillietherTranche: map[uint64]*markets.EntryCost{},
+			BuyByUsdTranche:          map[uint64]*markets.EntryCost{},
+			ShortByUsdTranche:        map[uint64]*markets.EntryCost{},
+		}
+		for _, tranche := range tranches.MillietherTranches {
+			allowance := currency.Milliether(tranche).Ether()
+			if cost := liquidity.GetEntryCost(book, allowance, market, false); cost != nil {
+				costs.BuyByMillietherTranche[tranche] = translateEntryCost(cost, gas, gasPrice, ethusd)
+			}
+			if cost := liquidity.GetEntryCost(book, allowance, market, true); cost != nil {
+				costs.ShortByMillietherTranche[tranche] = translateEntryCost(cost, gas, gasPrice, ethusd)
+			}
+		}
+		if ethusd > 0 {
+			for _, tranche := range tranches.USDTranches {
+				allowance := usdToEther(tranche, ethusd)
+				if cost := liquidity.GetEntryCost(book, allowance, market, false); cost != nil {
+					costs.BuyByUsdTranche[tranche] = translateEntryCost(cost, gas, gasPrice, ethusd)
+				}
+				if cost := liquidity.GetEntryCost(book, allowance, market, true); cost != nil {
+					costs.ShortByUsdTranche[tranche] = translateEntryCost(cost, gas, gasPrice, ethusd)
+				}
+			}
+		}
+		costsByOutcome[outcome.Id] = costs
+	}
+	return costsByOutcome
+}
+
+func translateEntryCost(cost *liquidity.EntryCost, gas GasConfig, gasPrice *big.Int, ethusd float64) *markets.EntryCost {
+	shares, _ := cost.Shares.Float32()
+	averagePrice, _ := cost.AveragePrice.Float32()
+	slippage, _ := cost.Slippage.Float32()
+	filled, _ := cost.Filled.Float32()
+	translated := &markets.EntryCost{
+		Shares:       shares,
+		AveragePrice: averagePrice,
+		Slippage:     slippage,
+		Filled:       filled,
+		Fills:        cost.Fills,
+	}
+	if gasPrice != nil {
+		gasEth, _ := gas.GetGasCost(cost.Fills, gasPrice).Float64()
+		translated.GasEth = float32(gasEth)
+		translated.GasUsd = float32(gasEth * ethusd)
+	}
+	return translated
+}
@@ -0,0 +1,237 @@
+package markets
+
+import (
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/stateshape/augur-analyzer/pkg/proto/augur"
+	"github.com/stateshape/augur-analyzer/pkg/proto/markets"
+)
+
+const (
+	// Number of most recent events kept in the feed
+	MaxMarketEventFeedLength = 1000
+
+	// Liquidity collapsed if the retention ratio of a tranche
+	// falls below this fraction of its previous value
+	liquidityCollapseFraction = 0.5
+)
+
+type marketState struct {
+	ReportingState markets.ReportingState
+	Forking        bool
+	HasOrders      bool
+	Predictions    map[uint64]float64
+	// Retention ratios by milliether tranche
+	RetentionRatios map[uint64]float32
+	// Converts a change in prediction into percentage points, which
+	// normalizes scalar values by the price range of the market
+	PredictionScale float64
+}
+
+// EventDetector compares the markets of consecutive blocks and emits
+// events for the changes it observes
+type EventDetector struct {
+	PredictionMoveThreshold float64
+
+	previous map[string]*marketState
+}
+
+func NewEventDetector(predictionMoveThreshold float64) *EventDetector {
+	return &EventDetector{
+		PredictionMoveThreshold: predictionMoveThreshold,
+	}
+}
+
+// Detect returns the events that happened since the last call to Detect and
+// remembers the current state of the markets. The first call only records
+// the state of the markets since there is nothing to compare against.
+func (ed *EventDetector) Detect(block uint64, ms []*markets.Market, msd *MarketsData) []*markets.MarketEvent {
+	current := map[string]*marketState{}
+	for _, m := range ms {
+		var md *MarketData
+		if msd != nil {
+			md = msd.ByMarketID[m.Id]
+		}
+		current[m.Id] = getMarketState(m, md)
+	}
+
+	events := []*markets.MarketEvent{}
+	if ed.previous != nil {
+		for _, m := range ms {
+			events = append(events, ed.compare(block, m.Id, ed.previous[m.Id], current[m.Id])...)
+		}
+	}
+	// Carry over markets missing from this block, such as markets which
+	// failed to translate, so they are not reported as created again
+	for id, state := range ed.previous {
+		if _, ok := current[id]; !ok {
+			current[id] = state
+		}
+	}
+	ed.previous = current
+
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].MarketId != events[j].MarketId {
+			return events[i].MarketId < events[j].MarketId
+		}
+		return events[i].Type < events[j].Type
+	})
+	return events
+}
+
+func (ed *EventDetector) compare(block uint64, marketID string, before, after *marketState) []*markets.MarketEvent {
+	event := func(eventType markets.MarketEventType) *markets.MarketEvent {
+		return &markets.MarketEvent{
+			Type:     eventType,
+			Block:    block,
+			MarketId: marketID,
+		}
+	}
+
+	if before == nil {
+		created := event(markets.MarketEventType_MARKET_CREATED)
+		created.ReportingStateAfter = after.ReportingState
+		return []*markets.MarketEvent{created}
+	}
+
+	events := []*markets.MarketEvent{}
+	if before.ReportingState != after.ReportingState {
+		changed := event(markets.MarketEventType_REPORTING_STATE_CHANGED)
+		changed.ReportingStateBefore = before.ReportingState
+		changed.ReportingStateAfter = after.ReportingState
+		events = append(events, changed)
+	}
+	if !before.Forking && after.Forking {
+		forking := event(markets.MarketEventType_FORKING_STARTED)
+		forking.ReportingStateBefore = before.ReportingState
+		forking.ReportingStateAfter = after.ReportingState
+		events = append(events, forking)
+	}
+	if !before.HasOrders && after.HasOrders {
+		events = append(events, event(markets.MarketEventType_ORDER_BOOK_OPENED))
+	}
+	if before.HasOrders && !after.HasOrders {
+		events = append(events, event(markets.MarketEventType_ORDER_BOOK_EMPTIED))
+	}
+
+	tranches := []uint64{}
+	for tranche := range after.RetentionRatios {
+		tranches = append(tranches, tranche)
+	}
+	sort.Slice(tranches, func(i, j int) bool { return tranches[i] < tranches[j] })
+	for _, tranche := range tranches {
+		previous, ok := before.RetentionRatios[tranche]
+		if !ok || previous <= 0 || after.RetentionRatios[tranche] >= previous*liquidityCollapseFraction {
+			continue
+		}
+		// Report only the smallest tranche since larger
+		// tranches almost always collapse along with it
+		collapsed := event(markets.MarketEventType_LIQUIDITY_COLLAPSED)
+		collapsed.MillietherTranche = tranche
+		collapsed.RetentionRatioBefore = previous
+		collapsed.RetentionRatioAfter = after.RetentionRatios[tranche]
+		events = append(events, collapsed)
+		break
+	}
+
+	outcomes := []uint64{}
+	for outcome := range after.Predictions {
+		outcomes = append(outcomes, outcome)
+	}
+	sort.Slice(outcomes, func(i, j int) bool { return outcomes[i] < outcomes[j] })
+	for _, outcome := range outcomes {
+		previous, ok := before.Predictions[outcome]
+		if !ok {
+			continue
+		}
+		if math.Abs(after.Predictions[outcome]-previous)*after.PredictionScale < ed.PredictionMoveThreshold {
+			continue
+		}
+		moved := event(markets.MarketEventType_PREDICTION_MOVED)
+		moved.OutcomeId = outcome
+		moved.PredictionBefore = float32(previous)
+		moved.PredictionAfter = float32(after.Predictions[outcome])
+		events = append(events, moved)
+	}
+	return events
+}
+
+func getMarketState(m *markets.Market, md *MarketData) *marketState {
+	state := &marketState{
+		Predictions:     map[uint64]float64{},
+		PredictionScale: 1,
+		RetentionRatios: map[uint64]float32{},
+	}
+	if m.LiquidityMetrics != nil {
+		for tranche, rr := range m.LiquidityMetrics.RetentionRatioByMillietherTranche {
+			state.RetentionRatios[tranche] = rr
+		}
+	}
+	for _, list := range m.Bids {
+		if len(list.LiquidityAtPrice) > 0 {
+			state.HasOrders = true
+		}
+	}
+	for _, list := range m.Asks {
+		if len(list.LiquidityAtPrice) > 0 {
+			state.HasOrders = true
+		}
+	}
+
+	if md != nil && md.Info != nil {
+		state.ReportingState = mapReportingState(md.Info.ReportingState)
+		state.Forking = md.Info.Forking || md.Info.ReportingState == augur.ReportingState_FORKING
+		if priceRange, err := getPriceRange(md); err == nil && priceRange > 0 && m.MarketType == markets.MarketType_SCALAR {
+			state.PredictionScale = 100 / priceRange
+		}
+	}
+
+	for _, prediction := range m.Predictions {
+		// The estimate of an invalid resolution is not an outcome
+		if prediction.IsInvalid {
+			continue
+		}
+		if m.MarketType == markets.MarketType_SCALAR {
+			state.Predictions[prediction.OutcomeId] = float64(prediction.Value)
+			continue
+		}
+		state.Predictions[prediction.OutcomeId] = float64(prediction.Percent)
+	}
+	return state
+}
+
+// EventFeed keeps the most recent market events for consumers polling them
+type EventFeed struct {
+	mu     sync.RWMutex
+	events []*markets.MarketEvent
+}
+
+func NewEventFeed() *EventFeed {
+	return &EventFeed{
+		events: []*markets.MarketEvent{},
+	}
+}
+
+func (ef *EventFeed) Append(events ...*markets.MarketEvent) {
+	ef.mu.Lock()
+	defer ef.mu.Unlock()
+	ef.events = append(ef.events, events...)
+	if len(ef.events) > MaxMarketEventFeedLength {
+		ef.events = ef.events[len(ef.events)-MaxMarketEventFeedLength:]
+	}
+}
+
+// Since returns the events of blocks after the block provided, oldest first
+func (ef *EventFeed) Since(block uint64) []*markets.MarketEvent {
+	ef.mu.RLock()
+	defer ef.mu.RUnlock()
+	events := []*markets.MarketEvent{}
+	for _, event := range ef.events {
+		if event.Block > block {
+			events = append(events, event)
+		}
+	}
+	return events
+}
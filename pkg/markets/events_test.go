@@ -0,0 +1,106 @@
+package markets_test
+
+import (
+	"testing"
+
+	"github.com/stateshape/augur-analyzer/pkg/markets"
+	"github.com/stateshape/augur-analyzer/pkg/proto/augur"
+	protomarkets "github.com/stateshape/augur-analyzer/pkg/proto/markets"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventDetectorDetect(t *testing.T) {
+	market := func(id string, percent float32, withOrders bool) *protomarkets.Market {
+		m := &protomarkets.Market{
+			Id:         id,
+			MarketType: protomarkets.MarketType_YESNO,
+			Predictions: []*protomarkets.Prediction{
+				{Name: "yes", Percent: percent, OutcomeId: 1},
+				// Moves as much as the outcome, but is not reported
+				{Name: "Invalid", Percent: 100 - percent, OutcomeId: 2, IsInvalid: true},
+			},
+			Bids: map[uint64]*protomarkets.ListLiquidityAtPrice{},
+		}
+		if withOrders {
+			m.Bids[1] = &protomarkets.ListLiquidityAtPrice{
+				LiquidityAtPrice: []*protomarkets.LiquidityAtPrice{{Price: 0.5, Amount: 1}},
+			}
+		}
+		return m
+	}
+	data := func(states map[string]augur.ReportingState) *markets.MarketsData {
+		msd := &markets.MarketsData{ByMarketID: map[string]*markets.MarketData{}}
+		for id, state := range states {
+			msd.ByMarketID[id] = &markets.MarketData{
+				Info: &augur.MarketInfo{Id: id, MinPrice: "0", MaxPrice: "1", ReportingState: state},
+			}
+		}
+		return msd
+	}
+
+	detector := markets.NewEventDetector(10)
+
+	// Nothing to compare against on the first block
+	events := detector.Detect(1, []*protomarkets.Market{market("a", 50, true)}, data(map[string]augur.ReportingState{
+		"a": augur.ReportingState_PRE_REPORTING,
+	}))
+	assert.Equal(t, 0, len(events))
+
+	events = detector.Detect(2, []*protomarkets.Market{market("a", 65, false), market("b", 50, false)}, data(map[string]augur.ReportingState{
+		"a": augur.ReportingState_DESIGNATED_REPORTING,
+		"b": augur.ReportingState_PRE_REPORTING,
+	}))
+	assert.Equal(t, 4, len(events))
+
+	assert.Equal(t, protomarkets.MarketEventType_REPORTING_STATE_CHANGED, events[0].Type)
+	assert.Equal(t, uint64(2), events[0].Block)
+	assert.Equal(t, protomarkets.ReportingState_PRE_REPORTING, events[0].ReportingStateBefore)
+	assert.Equal(t, protomarkets.ReportingState_DESIGNATED_REPORTING, events[0].ReportingStateAfter)
+
+	assert.Equal(t, protomarkets.MarketEventType_PREDICTION_MOVED, events[1].Type)
+	assert.Equal(t, float32(50), events[1].PredictionBefore)
+	assert.Equal(t, float32(65), events[1].PredictionAfter)
+
+	assert.Equal(t, protomarkets.MarketEventType_ORDER_BOOK_EMPTIED, events[2].Type)
+
+	assert.Equal(t, protomarkets.MarketEventType_MARKET_CREATED, events[3].Type)
+	assert.Equal(t, "b", events[3].MarketId)
+
+	// Small moves are not reported
+	events = detector.Detect(3, []*protomarkets.Market{market("a", 70, false), market("b", 50, false)}, data(map[string]augur.ReportingState{
+		"a": augur.ReportingState_DESIGNATED_REPORTING,
+		"b": augur.ReportingState_PRE_REPORTING,
+	}))
+	assert.Equal(t, 0, len(events))
+}
+
+func TestEventDetectorLiquidityCollapsed(t *testing.T) {
+	market := func(retentionRatios map[uint64]float32) *protomarkets.Market {
+		return &protomarkets.Market{
+			Id:         "a",
+			MarketType: protomarkets.MarketType_YESNO,
+			LiquidityMetrics: &protomarkets.LiquidityMetrics{
+				RetentionRatioByMillietherTranche: retentionRatios,
+			},
+		}
+	}
+
+	detector := markets.NewEventDetector(10)
+	detector.Detect(1, []*protomarkets.Market{market(map[uint64]float32{100: 0.9, 1000: 0.8})}, nil)
+	events := detector.Detect(2, []*protomarkets.Market{market(map[uint64]float32{100: 0.3, 1000: 0.2})}, nil)
+	assert.Equal(t, 1, len(events))
+	assert.Equal(t, protomarkets.MarketEventType_LIQUIDITY_COLLAPSED, events[0].Type)
+	assert.Equal(t, uint64(100), events[0].MillietherTranche)
+	assert.Equal(t, float32(0.9), events[0].RetentionRatioBefore)
+	assert.Equal(t, float32(0.3), events[0].RetentionRatioAfter)
+}
+
+func TestEventFeedSince(t *testing.T) {
+	feed := markets.NewEventFeed()
+	for block := uint64(1); block <= markets.MaxMarketEventFeedLength+10; block++ {
+		feed.Append(&protomarkets.MarketEvent{Block: block})
+	}
+	assert.Equal(t, markets.MaxMarketEventFeedLength, len(feed.Since(0)))
+	assert.Equal(t, 5, len(feed.Since(markets.MaxMarketEventFeedLength+5)))
+}
@@ -0,0 +1,38 @@
+package markets
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/stateshape/augur-analyzer/pkg/markets/liquidity"
+	"github.com/stateshape/augur-analyzer/pkg/proto/augur"
+)
+
+// GetSettlementFee returns the fraction of the proceeds of closing a position
+// paid in fees, the sum of the reporting and market creator fee rates when the
+// augur node does not report the settlement fee
+func GetSettlementFee(info *augur.MarketInfo) (*big.Rat, error) {
+	var fee *big.Rat
+	var err error
+	if info.SettlementFee != "" {
+		if fee, err = liquidity.ParseDecimal(info.SettlementFee); err != nil {
+			return nil, err
+		}
+	} else {
+		fee = new(big.Rat)
+		for _, rate := range []string{info.ReportingFeeRate, info.MarketCreatorFeeRate} {
+			if rate == "" {
+				continue
+			}
+			parsed, err := liquidity.ParseDecimal(rate)
+			if err != nil {
+				return nil, err
+			}
+			fee.Add(fee, parsed)
+		}
+	}
+	if fee.Sign() < 0 || fee.Cmp(big.NewRat(1, 1)) >= 0 {
+		return nil, fmt.Errorf("Settlement fee must be in [0, 1): %s", fee.FloatString(6))
+	}
+	return fee, nil
+}
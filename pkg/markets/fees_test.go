@@ -0,0 +1,30 @@
+package markets_test
+
+import (
+	"testing"
+
+	"github.com/stateshape/augur-analyzer/pkg/markets"
+	"github.com/stateshape/augur-analyzer/pkg/proto/augur"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSettlementFee(t *testing.T) {
+	fee, err := markets.GetSettlementFee(&augur.MarketInfo{SettlementFee: "0.02", ReportingFeeRate: "0.01"})
+	assert.Nil(t, err)
+	assert.Equal(t, "1/50", fee.RatString())
+
+	// Without a settlement fee the rates add up
+	fee, err = markets.GetSettlementFee(&augur.MarketInfo{ReportingFeeRate: "0.01", MarketCreatorFeeRate: "0.005"})
+	assert.Nil(t, err)
+	assert.Equal(t, "3/200", fee.RatString())
+
+	fee, err = markets.GetSettlementFee(&augur.MarketInfo{})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, fee.Sign())
+
+	_, err = markets.GetSettlementFee(&augur.MarketInfo{SettlementFee: "1.5"})
+	assert.NotNil(t, err)
+	_, err = markets.GetSettlementFee(&augur.MarketInfo{SettlementFee: "fee"})
+	assert.NotNil(t, err)
+}
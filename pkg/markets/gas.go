@@ -0,0 +1,64 @@
+package markets
+
+import (
+	"math/big"
+
+	"github.com/stateshape/augur-analyzer/pkg/currency"
+	"github.com/stateshape/augur-analyzer/pkg/markets/liquidity"
+	"github.com/stateshape/augur-analyzer/pkg/proto/markets"
+)
+
+// Gas estimate of an Augur trade transaction taking a single order
+const DefaultGasPerFill = 500000
+
+var weiPerEther = new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+
+// GasConfig estimates the gas of taking orders
+type GasConfig struct {
+	GasPerFill uint64
+}
+
+var DefaultGasConfig = GasConfig{
+	GasPerFill: DefaultGasPerFill,
+}
+
+// GetGasCost returns the ETH paid in gas for taking a number of orders at the
+// gas price in wei
+func (c GasConfig) GetGasCost(fills uint64, gasPrice *big.Int) *big.Rat {
+	wei := new(big.Int).SetUint64(fills)
+	wei.Mul(wei, new(big.Int).SetUint64(c.GasPerFill))
+	wei.Mul(wei, gasPrice)
+	return new(big.Rat).SetFrac(wei, weiPerEther)
+}
+
+// GetExecutionCost deducts the gas paid for taking a number of orders from the
+// retention ratio of an allowance
+func (c GasConfig) GetExecutionCost(fills uint64, gasPrice *big.Int, allowance currency.Ether, retentionRatio *big.Rat, ethusd float64) *markets.ExecutionCost {
+	gas := c.GetGasCost(fills, gasPrice)
+	gasEth, _ := gas.Float64()
+
+	retention := new(big.Rat).Set(retentionRatio)
+	if exactAllowance := liquidity.DecimalFromFloat(allowance.Float64()); exactAllowance.Sign() > 0 {
+		retention.Sub(retention, new(big.Rat).Quo(gas, exactAllowance))
+	}
+	if retention.Sign() < 0 {
+		retention.SetInt64(0)
+	}
+	ratio, _ := retention.Float32()
+
+	return &markets.ExecutionCost{
+		Fills:          fills,
+		GasEth:         float32(gasEth),
+		GasUsd:         float32(gasEth * ethusd),
+		RetentionRatio: ratio,
+	}
+}
+
+// getGasPriceGwei converts a gas price in wei, zero when it is unknown
+func getGasPriceGwei(gasPrice *big.Int) float32 {
+	if gasPrice == nil {
+		return 0
+	}
+	gwei, _ := new(big.Rat).SetFrac(gasPrice, big.NewInt(1000000000)).Float32()
+	return gwei
+}
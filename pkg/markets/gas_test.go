@@ -0,0 +1,30 @@
+package markets_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stateshape/augur-analyzer/pkg/currency"
+	"github.com/stateshape/augur-analyzer/pkg/markets"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGasConfig(t *testing.T) {
+	gas := markets.GasConfig{GasPerFill: 500000}
+	// 20 gwei
+	gasPrice := big.NewInt(20000000000)
+
+	// 4 fills of 500000 gas at 20 gwei cost 0.04 ETH
+	assert.Equal(t, "1/25", gas.GetGasCost(4, gasPrice).RatString())
+
+	cost := gas.GetExecutionCost(4, gasPrice, currency.Ether(1), big.NewRat(9, 10), 200)
+	assert.Equal(t, uint64(4), cost.Fills)
+	assert.InDelta(t, 0.04, cost.GasEth, 1e-6)
+	assert.InDelta(t, 8, cost.GasUsd, 1e-4)
+	assert.InDelta(t, 0.86, cost.RetentionRatio, 1e-6)
+
+	// Gas costs dominate small allowances, the ratio does not go negative
+	cost = gas.GetExecutionCost(4, gasPrice, currency.Ether(0.01), big.NewRat(9, 10), 200)
+	assert.Equal(t, float32(0), cost.RetentionRatio)
+}
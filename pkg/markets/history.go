@@ -0,0 +1,129 @@
+package markets
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/stateshape/augur-analyzer/pkg/proto/augur"
+	"github.com/stateshape/augur-analyzer/pkg/proto/markets"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+)
+
+// Number of hourly snapshots kept in the universe history, daily
+// snapshots are kept forever
+const MaxHourlyUniverseSnapshots = 24 * 30
+
+// UniverseHistoryRecorder downsamples the universe snapshot of every block
+// into hourly and daily history and persists it to a file
+type UniverseHistoryRecorder struct {
+	path    string
+	mu      sync.Mutex
+	history *markets.UniverseHistory
+}
+
+// NewUniverseHistoryRecorder creates a recorder resuming the history
+// persisted at path by a previous run
+func NewUniverseHistoryRecorder(path string) (*UniverseHistoryRecorder, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	r := &UniverseHistoryRecorder{
+		path:    path,
+		history: &markets.UniverseHistory{},
+	}
+	content, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return r, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := jsonpb.UnmarshalString(string(content), r.history); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Record replaces the snapshot of the current hour and day, persists the
+// history and returns a copy of it
+func (r *UniverseHistoryRecorder) Record(snapshot *markets.UniverseSnapshot, now time.Time) (*markets.UniverseHistory, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.history.GenerationTime = uint64(now.Unix())
+	r.history.Hourly = recordSnapshot(r.history.Hourly, snapshot, uint64(time.Hour/time.Second))
+	if len(r.history.Hourly) > MaxHourlyUniverseSnapshots {
+		r.history.Hourly = r.history.Hourly[len(r.history.Hourly)-MaxHourlyUniverseSnapshots:]
+	}
+	r.history.Daily = recordSnapshot(r.history.Daily, snapshot, uint64(24*time.Hour/time.Second))
+
+	marshaler := jsonpb.Marshaler{}
+	content, err := marshaler.MarshalToString(r.history)
+	if err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(r.path+".tmp", []byte(content), 0644); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(r.path+".tmp", r.path); err != nil {
+		return nil, err
+	}
+	return proto.Clone(r.history).(*markets.UniverseHistory), nil
+}
+
+// recordSnapshot replaces the last snapshot if it is in the same interval,
+// otherwise appends the snapshot
+func recordSnapshot(snapshots []*markets.UniverseSnapshot, snapshot *markets.UniverseSnapshot, seconds uint64) []*markets.UniverseSnapshot {
+	if len(snapshots) > 0 && snapshots[len(snapshots)-1].Time/seconds == snapshot.Time/seconds {
+		snapshots[len(snapshots)-1] = snapshot
+		return snapshots
+	}
+	return append(snapshots, snapshot)
+}
+
+// GetUniverseSnapshot aggregates the markets of a block
+func GetUniverseSnapshot(block uint64, now time.Time, ms []*markets.Market, msd *MarketsData) *markets.UniverseSnapshot {
+	snapshot := &markets.UniverseSnapshot{
+		Block:                        block,
+		Time:                         uint64(now.Unix()),
+		TotalMarkets:                 uint64(len(ms)),
+		TotalMarketsCapitalization:   deriveTotalMarketsCapitalization(ms),
+		TotalVolume:                  &markets.Price{},
+		TotalMarketsByReportingState: map[string]uint64{},
+	}
+	for _, m := range ms {
+		addPrice(snapshot.TotalVolume, m.Volume)
+
+		md, ok := msd.ByMarketID[m.Id]
+		if !ok {
+			continue
+		}
+		if md.Info != nil {
+			snapshot.TotalMarketsByReportingState[mapReportingState(md.Info.ReportingState).String()]++
+		}
+		if md.Orders == nil {
+			continue
+		}
+		for _, ordersByOrderType := range md.Orders.OrdersByOrderIdByOrderTypeByOutcome {
+			for _, ordersByOrderID := range []*augur.GetOrdersResponse_OrdersByOrderId{
+				ordersByOrderType.BuyOrdersByOrderId,
+				ordersByOrderType.SellOrdersByOrderId,
+			} {
+				if ordersByOrderID == nil {
+					continue
+				}
+				for _, order := range ordersByOrderID.OrdersByOrderId {
+					if order.OrderState == augur.OrderState_OPEN {
+						snapshot.TotalOpenOrders++
+					}
+				}
+			}
+		}
+	}
+	return snapshot
+}
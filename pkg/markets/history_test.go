@@ -0,0 +1,87 @@
+package markets_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stateshape/augur-analyzer/pkg/markets"
+	"github.com/stateshape/augur-analyzer/pkg/proto/augur"
+	protomarkets "github.com/stateshape/augur-analyzer/pkg/proto/markets"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetUniverseSnapshot(t *testing.T) {
+	ms := []*protomarkets.Market{
+		{Id: "a", MarketCapitalization: &protomarkets.Price{Eth: 1}, Volume: &protomarkets.Price{Eth: 2}},
+		{Id: "b", MarketCapitalization: &protomarkets.Price{Eth: 3}, Volume: &protomarkets.Price{Eth: 4}},
+	}
+	msd := &markets.MarketsData{
+		ByMarketID: map[string]*markets.MarketData{
+			"a": {
+				Info: &augur.MarketInfo{Id: "a", ReportingState: augur.ReportingState_PRE_REPORTING},
+				Orders: &augur.GetOrdersResponse_OrdersByOrderIdByOrderTypeByOutcome{
+					OrdersByOrderIdByOrderTypeByOutcome: map[uint64]*augur.GetOrdersResponse_OrdersByOrderIdByOrderType{
+						1: {
+							BuyOrdersByOrderId: &augur.GetOrdersResponse_OrdersByOrderId{
+								OrdersByOrderId: map[string]*augur.Order{
+									"1": {OrderState: augur.OrderState_OPEN},
+									"2": {OrderState: augur.OrderState_FILLED},
+								},
+							},
+							SellOrdersByOrderId: &augur.GetOrdersResponse_OrdersByOrderId{
+								OrdersByOrderId: map[string]*augur.Order{
+									"3": {OrderState: augur.OrderState_OPEN},
+								},
+							},
+						},
+					},
+				},
+			},
+			"b": {Info: &augur.MarketInfo{Id: "b", ReportingState: augur.ReportingState_FINALIZED}},
+		},
+	}
+
+	snapshot := markets.GetUniverseSnapshot(10, time.Unix(100, 0), ms, msd)
+	assert.Equal(t, uint64(10), snapshot.Block)
+	assert.Equal(t, uint64(100), snapshot.Time)
+	assert.Equal(t, uint64(2), snapshot.TotalMarkets)
+	assert.Equal(t, float32(4), snapshot.TotalMarketsCapitalization.Eth)
+	assert.Equal(t, float32(6), snapshot.TotalVolume.Eth)
+	assert.Equal(t, uint64(2), snapshot.TotalOpenOrders)
+	assert.Equal(t, map[string]uint64{"PRE_REPORTING": 1, "FINALIZED": 1}, snapshot.TotalMarketsByReportingState)
+}
+
+func TestUniverseHistoryRecorder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "history")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "universe-history.json")
+
+	recorder, err := markets.NewUniverseHistoryRecorder(path)
+	assert.Nil(t, err)
+
+	// A snapshot every 20 minutes for two days
+	var history *protomarkets.UniverseHistory
+	for i := 0; i < 2*24*3; i++ {
+		now := time.Unix(int64(i*20*60), 0)
+		history, err = recorder.Record(&protomarkets.UniverseSnapshot{Block: uint64(i), Time: uint64(now.Unix())}, now)
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, 48, len(history.Hourly))
+	assert.Equal(t, 2, len(history.Daily))
+	assert.Equal(t, uint64(2), history.Hourly[0].Block)
+	assert.Equal(t, uint64(71), history.Daily[0].Block)
+	assert.Equal(t, uint64(143), history.Daily[1].Block)
+
+	// The history survives a restart
+	recorder, err = markets.NewUniverseHistoryRecorder(path)
+	assert.Nil(t, err)
+	history, err = recorder.Record(&protomarkets.UniverseSnapshot{Block: 144, Time: 2 * 24 * 3600}, time.Unix(2*24*3600, 0))
+	assert.Nil(t, err)
+	assert.Equal(t, 49, len(history.Hourly))
+	assert.Equal(t, 3, len(history.Daily))
+}
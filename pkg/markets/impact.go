@@ -0,0 +1,183 @@
+package markets
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/stateshape/augur-analyzer/pkg/markets/liquidity"
+	"github.com/stateshape/augur-analyzer/pkg/proto/markets"
+)
+
+const (
+	DefaultPriceImpactShareLadder = "1,10,100,1000"
+	DefaultPriceImpactEtherLadder = "0.1,1,10,100"
+)
+
+// PriceImpactConfig holds the sizes of the market orders of price impact
+// curves, in shares and in ETH
+type PriceImpactConfig struct {
+	ShareLadder []*big.Rat
+	EtherLadder []*big.Rat
+}
+
+// ParsePriceImpactConfig creates a config from comma separated lists of
+// decimal share and ETH amounts
+func ParsePriceImpactConfig(shareLadder, etherLadder string) (*PriceImpactConfig, error) {
+	shares, err := parseLadder(shareLadder)
+	if err != nil {
+		return nil, err
+	}
+	ether, err := parseLadder(etherLadder)
+	if err != nil {
+		return nil, err
+	}
+	return &PriceImpactConfig{
+		ShareLadder: shares,
+		EtherLadder: ether,
+	}, nil
+}
+
+// DefaultPriceImpactConfig uses the default share and ETH ladders
+func DefaultPriceImpactConfig() *PriceImpactConfig {
+	config, _ := ParsePriceImpactConfig(DefaultPriceImpactShareLadder, DefaultPriceImpactEtherLadder)
+	return config
+}
+
+func parseLadder(list string) ([]*big.Rat, error) {
+	ladder := []*big.Rat{}
+	for _, field := range strings.Split(list, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		size, err := liquidity.ParseDecimal(field)
+		if err != nil {
+			return nil, err
+		}
+		if size.Sign() <= 0 {
+			return nil, fmt.Errorf("Price impact ladder sizes must be positive: %s", field)
+		}
+		ladder = append(ladder, size)
+	}
+	return ladder, nil
+}
+
+// GetPriceImpactCurves computes the price impact curves of every outcome with
+// at least one order from the aggregated order books of a market
+func GetPriceImpactCurves(bids, asks map[uint64]*markets.ListLiquidityAtPrice, market liquidity.MarketData, config *PriceImpactConfig) map[uint64]*markets.PriceImpactCurves {
+	curvesByOutcome := map[uint64]*markets.PriceImpactCurves{}
+	outcomes := map[uint64]struct{}{}
+	for outcome := range bids {
+		outcomes[outcome] = struct{}{}
+	}
+	for outcome := range asks {
+		outcomes[outcome] = struct{}{}
+	}
+
+	for outcome := range outcomes {
+		outcomeBids, outcomeAsks := levels(bids[outcome]), levels(asks[outcome])
+		if len(outcomeBids) == 0 && len(outcomeAsks) == 0 {
+			continue
+		}
+		book := liquidity.NewOutcomeOrderBook(outcomeBids, outcomeAsks)
+		curves := &markets.PriceImpactCurves{
+			Buy:  []*markets.PriceImpactPoint{},
+			Sell: []*markets.PriceImpactPoint{},
+		}
+		for _, ladder := range []struct {
+			sizes []*big.Rat
+			unit  markets.PriceImpactUnit
+		}{
+			{config.ShareLadder, markets.PriceImpactUnit_SHARES},
+			{config.EtherLadder, markets.PriceImpactUnit_ETHER},
+		} {
+			for _, size := range ladder.sizes {
+				if len(outcomeAsks) > 0 {
+					curves.Buy = append(curves.Buy, getPriceImpactPoint(book, size, ladder.unit, market, true))
+				}
+				if len(outcomeBids) > 0 {
+					curves.Sell = append(curves.Sell, getPriceImpactPoint(book, size, ladder.unit, market, false))
+				}
+			}
+		}
+		curvesByOutcome[outcome] = curves
+	}
+	return curvesByOutcome
+}
+
+// getPriceImpactPoint trades the size against a clone of the book, buying
+// by taking the asks or selling by taking the bids
+func getPriceImpactPoint(book liquidity.OutcomeOrderBook, size *big.Rat, unit markets.PriceImpactUnit, market liquidity.MarketData, buy bool) *markets.PriceImpactPoint {
+	clone := book.DeepClone()
+	shares := new(big.Rat)
+	// Paid above the min price when buying, below the max price when selling
+	value := new(big.Rat)
+	switch {
+	case unit == markets.PriceImpactUnit_SHARES && buy:
+		_, before := clone.ExactLevels()
+		proceeds := clone.CloseShortFillOnly(size, market, false)
+		_, after := clone.ExactLevels()
+		shares.Sub(getTotalAmount(before), getTotalAmount(after))
+		// Closing a short receives the max price less the price paid
+		value.Sub(new(big.Rat).Mul(shares, new(big.Rat).Sub(market.MaxPrice, market.MinPrice)), proceeds)
+	case unit == markets.PriceImpactUnit_SHARES:
+		before, _ := clone.ExactLevels()
+		proceeds := clone.CloseLongFillOnly(size, market, false)
+		after, _ := clone.ExactLevels()
+		shares.Sub(getTotalAmount(before), getTotalAmount(after))
+		// Closing a long receives the price less the min price
+		value.Sub(new(big.Rat).Mul(shares, new(big.Rat).Sub(market.MaxPrice, market.MinPrice)), proceeds)
+	case buy:
+		var cost *big.Rat
+		shares, cost = clone.OpenLongFillOnly(size, market, false)
+		value.Set(cost)
+	default:
+		var cost *big.Rat
+		shares, cost = clone.OpenShortFillOnly(size, market, false)
+		value.Set(cost)
+	}
+
+	point := &markets.PriceImpactPoint{
+		Unit: unit,
+	}
+	point.Size, _ = size.Float32()
+	point.Shares, _ = shares.Float32()
+	if shares.Sign() > 0 {
+		costPerShare := new(big.Rat).Quo(value, shares)
+		average := new(big.Rat).Add(market.MinPrice, costPerShare)
+		if !buy {
+			average.Sub(market.MaxPrice, costPerShare)
+		}
+		point.AveragePrice, _ = average.Float32()
+	}
+
+	bids, asks := clone.ExactLevels()
+	remaining := firstNonEmptyLevel(bids)
+	if buy {
+		remaining = firstNonEmptyLevel(asks)
+	}
+	if remaining == nil {
+		point.Exhausted = true
+		return point
+	}
+	point.MarginalPrice, _ = remaining.Price.Float32()
+	return point
+}
+
+func getTotalAmount(levels []*liquidity.Level) *big.Rat {
+	total := new(big.Rat)
+	for _, level := range levels {
+		total.Add(total, level.Amount)
+	}
+	return total
+}
+
+func firstNonEmptyLevel(levels []*liquidity.Level) *liquidity.Level {
+	for _, level := range levels {
+		if level.Amount.Sign() > 0 {
+			return level
+		}
+	}
+	return nil
+}
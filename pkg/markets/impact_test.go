@@ -0,0 +1,74 @@
+package markets_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stateshape/augur-analyzer/pkg/markets"
+	"github.com/stateshape/augur-analyzer/pkg/markets/liquidity"
+	protomarkets "github.com/stateshape/augur-analyzer/pkg/proto/markets"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPriceImpactCurves(t *testing.T) {
+	config, err := markets.ParsePriceImpactConfig("3, 100", "1")
+	assert.Nil(t, err)
+	_, err = markets.ParsePriceImpactConfig("1,0", "1")
+	assert.NotNil(t, err)
+
+	market := liquidity.MarketData{MinPrice: big.NewRat(0, 1), MaxPrice: big.NewRat(1, 1)}
+	bids := map[uint64]*protomarkets.ListLiquidityAtPrice{
+		0: {LiquidityAtPrice: []*protomarkets.LiquidityAtPrice{{Price: 0.4, Amount: 5}, {Price: 0.3, Amount: 5}}},
+	}
+	asks := map[uint64]*protomarkets.ListLiquidityAtPrice{
+		0: {LiquidityAtPrice: []*protomarkets.LiquidityAtPrice{{Price: 0.6, Amount: 2}, {Price: 0.7, Amount: 10}}},
+		1: {LiquidityAtPrice: []*protomarkets.LiquidityAtPrice{{Price: 0.5, Amount: 1}}},
+	}
+
+	curves := markets.GetPriceImpactCurves(bids, asks, market, config)
+	assert.Len(t, curves, 2)
+	assert.Len(t, curves[0].Buy, 3)
+	assert.Len(t, curves[0].Sell, 3)
+	assert.Len(t, curves[1].Buy, 3)
+	assert.Len(t, curves[1].Sell, 0)
+
+	// 2 shares at 0.6 and 1 share at 0.7
+	buy := curves[0].Buy[0]
+	assert.Equal(t, protomarkets.PriceImpactUnit_SHARES, buy.Unit)
+	assert.Equal(t, float32(3), buy.Shares)
+	assert.InDelta(t, 1.9/3, buy.AveragePrice, 1e-6)
+	assert.Equal(t, float32(0.7), buy.MarginalPrice)
+	assert.False(t, buy.Exhausted)
+
+	// The whole side fills 12 of the 100 shares
+	buy = curves[0].Buy[1]
+	assert.Equal(t, float32(12), buy.Shares)
+	assert.InDelta(t, 8.2/12, buy.AveragePrice, 1e-6)
+	assert.True(t, buy.Exhausted)
+
+	// 1 ETH buys 5/3 shares within the best ask
+	buy = curves[0].Buy[2]
+	assert.Equal(t, protomarkets.PriceImpactUnit_ETHER, buy.Unit)
+	assert.InDelta(t, 5.0/3, buy.Shares, 1e-6)
+	assert.InDelta(t, 0.6, buy.AveragePrice, 1e-6)
+	assert.Equal(t, float32(0.6), buy.MarginalPrice)
+
+	// 3 shares at 0.4
+	sell := curves[0].Sell[0]
+	assert.Equal(t, float32(3), sell.Shares)
+	assert.InDelta(t, 0.4, sell.AveragePrice, 1e-6)
+	assert.Equal(t, float32(0.4), sell.MarginalPrice)
+
+	// The whole side fills 10 of the 100 shares
+	sell = curves[0].Sell[1]
+	assert.Equal(t, float32(10), sell.Shares)
+	assert.InDelta(t, 0.35, sell.AveragePrice, 1e-6)
+	assert.True(t, sell.Exhausted)
+
+	// Shorting at 0.4 costs 0.6 per share
+	sell = curves[0].Sell[2]
+	assert.InDelta(t, 5.0/3, sell.Shares, 1e-6)
+	assert.InDelta(t, 0.4, sell.AveragePrice, 1e-6)
+	assert.Equal(t, float32(0.4), sell.MarginalPrice)
+}
@@ -0,0 +1,105 @@
+package markets
+
+import (
+	"math"
+	"strings"
+
+	"github.com/stateshape/augur-analyzer/pkg/proto/augur"
+	"github.com/stateshape/augur-analyzer/pkg/proto/markets"
+)
+
+const (
+	// Probability of an invalid resolution before looking at prices
+	invalidBaseProbability = 0.02
+	// Probability of an invalid resolution of markets without a resolution source
+	invalidUnsourcedProbability = 0.1
+	// Probability of an invalid resolution implied by every outcome bid at
+	// the invalid payout while sellers hold out for a premium over it
+	invalidPricedProbability = 0.5
+	// Sharpens the bid signal so that only outcomes bid close to the invalid
+	// payout move the estimate, e.g. bids at 80% of the payout keep 0.17 of it
+	invalidBidSignalExponent = 8
+	// Overround of the best asks, per outcome, of a valid market and of a
+	// market where sellers price in an invalid resolution
+	invalidNormalOverround = 0.025
+	invalidFullOverround   = 0.15
+)
+
+// GetInvalidProbability estimates the probability that a market resolves
+// invalid. Markets with a consensus are certain. Otherwise a prior, higher for
+// markets without a resolution source, is raised by the joint pricing of the
+// outcomes in the books.
+//
+// An invalid resolution pays every outcome an equal share of the range, so
+// every share is worth at least that payout. Traders pricing that in bid every
+// outcome up to the payout and do not sell any outcome close to it, so the
+// best asks sum to well over a complete set. A valid market can have every
+// outcome priced at the payout, e.g. an undecided yes/no market, but then
+// its sellers keep the asks close to a complete set, and a decided market has
+// an outcome bid far below the payout. Only the combination counts.
+func GetInvalidProbability(info *augur.MarketInfo, bestBids, bestAsks map[uint64]*markets.LiquidityAtPrice, minPrice, maxPrice float64) float64 {
+	if info == nil {
+		return 0
+	}
+	if info.Consensus != nil {
+		if info.Consensus.IsInvalid {
+			return 1
+		}
+		return 0
+	}
+
+	prior := invalidBaseProbability
+	if strings.TrimSpace(info.ResolutionSource) == "" {
+		prior = invalidUnsourcedProbability
+	}
+
+	signal := getInvalidPriceSignal(info.Outcomes, bestBids, bestAsks, minPrice, maxPrice)
+	priced := invalidPricedProbability * signal
+
+	// Combine the prior and the prices as independent evidence
+	return 1 - (1-prior)*(1-priced)
+}
+
+// getInvalidPriceSignal returns how strongly the best prices of the outcomes,
+// as a fraction of the range, price in an invalid resolution, from 0 to 1
+func getInvalidPriceSignal(outcomes []*augur.OutcomeInfo, bestBids, bestAsks map[uint64]*markets.LiquidityAtPrice, minPrice, maxPrice float64) float64 {
+	if len(outcomes) < 2 || maxPrice <= minPrice {
+		return 0
+	}
+	normalize := func(price float32) float64 {
+		return math.Max(0, math.Min(1, (float64(price)-minPrice)/(maxPrice-minPrice)))
+	}
+	payout := 1 / float64(len(outcomes))
+
+	// Every outcome must be bid, the least bid one sets the floor
+	floor := 1.0
+	asks := 0.0
+	for _, outcome := range outcomes {
+		bid, ok := bestBids[outcome.Id]
+		if !ok || bid == nil {
+			return 0
+		}
+		floor = math.Min(floor, normalize(bid.Price)/payout)
+		// Nobody selling an outcome is the widest ask there is
+		if ask, ok := bestAsks[outcome.Id]; ok && ask != nil {
+			asks += normalize(ask.Price)
+		} else {
+			asks++
+		}
+	}
+	overround := (asks - 1) / float64(len(outcomes))
+	heldOut := math.Max(0, math.Min(1, (overround-invalidNormalOverround)/(invalidFullOverround-invalidNormalOverround)))
+	return math.Pow(floor, invalidBidSignalExponent) * heldOut
+}
+
+// getInvalidPrediction returns the prediction of an invalid resolution,
+// listed after the predictions of the outcomes of the market
+func getInvalidPrediction(info *augur.MarketInfo, probability float64) *markets.Prediction {
+	return &markets.Prediction{
+		Name:      "Invalid",
+		Percent:   float32(probability * 100),
+		OutcomeId: uint64(len(info.Outcomes)),
+		Method:    markets.PredictionMethod_INVALID_ESTIMATE,
+		IsInvalid: true,
+	}
+}
@@ -0,0 +1,69 @@
+package markets_test
+
+import (
+	"testing"
+
+	"github.com/stateshape/augur-analyzer/pkg/markets"
+	"github.com/stateshape/augur-analyzer/pkg/proto/augur"
+	protomarkets "github.com/stateshape/augur-analyzer/pkg/proto/markets"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetInvalidProbability(t *testing.T) {
+	info := func(outcomes int, source string) *augur.MarketInfo {
+		info := &augur.MarketInfo{ResolutionSource: source}
+		for i := 0; i < outcomes; i++ {
+			info.Outcomes = append(info.Outcomes, &augur.OutcomeInfo{Id: uint64(i)})
+		}
+		return info
+	}
+	// prices are the best bid and ask of every outcome, in order of outcome
+	// id, an ask of 0 meaning nobody sells the outcome
+	probability := func(info *augur.MarketInfo, minPrice, maxPrice float64, prices ...float32) float64 {
+		bids := map[uint64]*protomarkets.LiquidityAtPrice{}
+		asks := map[uint64]*protomarkets.LiquidityAtPrice{}
+		for i := 0; i+1 < len(prices); i += 2 {
+			bids[uint64(i/2)] = &protomarkets.LiquidityAtPrice{Price: prices[i], Amount: 1}
+			if prices[i+1] > 0 {
+				asks[uint64(i/2)] = &protomarkets.LiquidityAtPrice{Price: prices[i+1], Amount: 1}
+			}
+		}
+		return markets.GetInvalidProbability(info, bids, asks, minPrice, maxPrice)
+	}
+	sourced := info(2, "https://example.com")
+
+	t.Run("Consensus", func(t *testing.T) {
+		invalid := &augur.MarketInfo{Consensus: &augur.NormalizedPayout{IsInvalid: true}}
+		assert.Equal(t, 1.0, probability(invalid, 0, 1, 0.5, 0.7, 0.5, 0.7))
+		valid := &augur.MarketInfo{Consensus: &augur.NormalizedPayout{}}
+		assert.Equal(t, 0.0, probability(valid, 0, 1, 0.5, 0.7, 0.5, 0.7))
+	})
+
+	t.Run("Yes/No", func(t *testing.T) {
+		// Undecided with tight books and decided markets stay at the prior
+		assert.InDelta(t, 0.02, probability(sourced, 0, 1, 0.49, 0.51, 0.49, 0.51), 1e-6)
+		assert.InDelta(t, 0.02, probability(sourced, 0, 1, 0.08, 0.1, 0.9, 0.92), 1e-3)
+		// Only the yes outcome has a book
+		assert.InDelta(t, 0.02, probability(sourced, 0, 1, 0, 0, 0.5, 0.7), 1e-6)
+		// Both outcomes bid at the payout while sellers ask a premium over it
+		assert.InDelta(t, 0.51, probability(sourced, 0, 1, 0.5, 0.7, 0.5, 0.7), 1e-6)
+		// Bids below the payout and a smaller premium weaken the signal
+		assert.InDelta(t, 0.147, probability(sourced, 0, 1, 0.45, 0.6, 0.45, 0.6), 1e-3)
+	})
+
+	t.Run("Missing resolution source", func(t *testing.T) {
+		assert.InDelta(t, 0.1, probability(info(2, ""), 0, 1), 1e-6)
+	})
+
+	t.Run("Categorical", func(t *testing.T) {
+		assert.InDelta(t, 0.02, probability(info(4, "source"), 0, 1, 0.24, 0.26, 0.24, 0.26, 0.24, 0.26, 0.24, 0.26), 1e-6)
+		// Nobody sells any outcome
+		assert.InDelta(t, 0.51, probability(info(4, "source"), 0, 1, 0.25, 0, 0.25, 0, 0.25, 0, 0.25, 0), 1e-6)
+	})
+
+	t.Run("Scalar", func(t *testing.T) {
+		assert.InDelta(t, 0.02, probability(sourced, 100, 200, 149, 151, 149, 151), 1e-6)
+		assert.InDelta(t, 0.51, probability(sourced, 100, 200, 150, 175, 150, 175), 1e-6)
+	})
+}
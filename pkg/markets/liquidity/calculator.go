@@ -1,7 +1,7 @@
 package liquidity
 
 import (
-	"math"
+	"math/big"
 
 	"github.com/stateshape/augur-analyzer/pkg/currency"
 )
@@ -13,73 +13,153 @@ func NewCalculator() Calculator {
 }
 
 // Allowance needs to be in the same denomination that the orders are priced in
-func (c *calculator) GetLiquidityRetentionRatio(sellingIncrement float64, allowance currency.Ether, market MarketData, books []OutcomeOrderBook) float64 {
+func (c *calculator) GetLiquidityRetentionRatio(allowance currency.Ether, market MarketData, books []OutcomeOrderBook) *big.Rat {
+	return c.getLiquidityRetentionRatio(allowance, market, books, nil)
+}
+
+func (c *calculator) TraceLiquidityRetentionRatio(allowance currency.Ether, market MarketData, books []OutcomeOrderBook) (*big.Rat, *RetentionTrace) {
+	trace := &RetentionTrace{
+		Allowance:    DecimalFromFloat(allowance.Float64()),
+		CompleteSets: new(big.Rat),
+		Increments:   []*IncrementTrace{},
+		Proceeds:     new(big.Rat),
+	}
+	trace.RetentionRatio = c.getLiquidityRetentionRatio(allowance, market, books, trace)
+	return trace.RetentionRatio, trace
+}
+
+// getLiquidityRetentionRatio records every increment in the trace unless it is nil
+func (c *calculator) getLiquidityRetentionRatio(allowance currency.Ether, market MarketData, books []OutcomeOrderBook, trace *RetentionTrace) *big.Rat {
+	exactAllowance := DecimalFromFloat(allowance.Float64())
+	priceRange := new(big.Rat).Sub(market.MaxPrice, market.MinPrice)
+	if exactAllowance.Sign() <= 0 || priceRange.Sign() <= 0 || len(books) == 0 {
+		return new(big.Rat)
+	}
+
 	// No rounding
-	completeSets := allowance.Float64() / (market.MaxPrice - market.MinPrice)
+	completeSets := new(big.Rat).Quo(exactAllowance, priceRange)
+	if trace != nil {
+		trace.CompleteSets.Set(completeSets)
+	}
 
 	// Keep track of money made from selling complete sets
-	totalProceeds := 0.0
+	totalProceeds := new(big.Rat)
 
 	// Handles yesNo and scalar markets
 	if len(books) < 2 {
-		totalProceeds += books[0].CloseLongFillOnly(completeSets, market, false)
-		totalProceeds += books[0].CloseShortFillOnly(completeSets, market, false)
-		return totalProceeds / allowance.Float64()
+		var increment *IncrementTrace
+		if trace != nil {
+			increment = newIncrementTrace(completeSets, StrategySingleBook, 0)
+		}
+		totalProceeds.Add(totalProceeds, closeFillOnly(books, 0, completeSets, market, false, increment))
+		totalProceeds.Add(totalProceeds, closeFillOnly(books, 0, completeSets, market, true, increment))
+		if trace != nil {
+			increment.Proceeds.Set(totalProceeds)
+			trace.Increments = append(trace.Increments, increment)
+			trace.Proceeds.Set(totalProceeds)
+		}
+		return totalProceeds.Quo(totalProceeds, exactAllowance)
 	}
 
-	// Handle categorical markets
-	for completeSets > 0 {
-		sharesForSale := math.Min(sellingIncrement, completeSets)
-		// Since there are len(outcomes) + 1 different ways to sell the shares back into the market
-		// try them each to observe their profitability.
+	// Handle categorical markets. Complete sets are sold either into the bids
+	// of every book, or into a single book by selling its outcome into its
+	// bids and buying back every other outcome from its asks. The mix of the
+	// two which yields the most proceeds is found exactly.
+	l := newLiquidation(books, market, completeSets)
+	eachBook := l.Best()
+	_, singleBook, _ := l.Solve(eachBook)
 
-		// Option 1: Sell all shares individually using the bids for each respective outcome.
-		// Option 2: Sell one share individually using the bids in its respective orderbook, and
-		//           sell the rest of the shares using the asks in the order book.
-
-		// estimatedProceeds[i] is the proceeds from selling complete sets into the outcomes[i] order book.
-		// estimatedProceeds[len(outcomes)] is the proceeds from selling each share individually into their respective order books
-		estimatedProceeds := make([]float64, len(books)+1)
+	if eachBook.Sign() > 0 {
+		var increment *IncrementTrace
+		if trace != nil {
+			increment = newIncrementTrace(eachBook, StrategyEachBook, 0)
+		}
+		proceedsFromSale := new(big.Rat)
 		for i := 0; i < len(books); i++ {
-			estimatedProceeds[len(books)] += books[i].CloseLongFillOnly(sharesForSale, market, true)
-			estimatedProceeds[i] += books[i].CloseLongFillOnly(sharesForSale, market, true)
-			estimatedProceeds[i] += books[i].CloseShortFillOnly(sharesForSale, market, true)
+			proceedsFromSale.Add(proceedsFromSale, closeFillOnly(books, i, eachBook, market, false, increment))
 		}
-
-		// Determine strategy which yields the most proceeds
-		maxProceeds := 0.0
-		maxProceedsIndex := 0
-		for i := 0; i < len(estimatedProceeds); i++ {
-			// If strategies are equally profitable we want to de-prioritize taking from each book (option 1 above), because intuitively taking from each book is asymmetric because it takes only bids, not asks. We already de-prioritize taking from each book because it's the last strategy in estimatedProceeds. However, because `estimatedProceeds[len(books)]` is accrued, it's subject to floating point drift, and so we use isMateriallyGreater() to ensure that we're only selecting last strategy if it's materially more profitable.
-			if isMateriallyGreater(estimatedProceeds[i], maxProceeds) {
-				maxProceeds = estimatedProceeds[i]
-				maxProceedsIndex = i
-			}
+		totalProceeds.Add(totalProceeds, proceedsFromSale)
+		completeSets.Sub(completeSets, eachBook)
+		if trace != nil {
+			increment.Proceeds.Set(proceedsFromSale)
+			increment.RemainingCompleteSets.Set(completeSets)
+			trace.Increments = append(trace.Increments, increment)
 		}
-
-		// Unable to sell, end incremental sell loop
-		if maxProceeds == 0 {
-			break
+	}
+	for i, shares := range singleBook {
+		if shares.Sign() == 0 {
+			continue
 		}
-
-		// Execute most profitable strategy
-		proceedsFromSale := 0.0
-		if maxProceedsIndex == len(books) {
-			for i := 0; i < len(books); i++ {
-				proceedsFromSale += books[i].CloseLongFillOnly(sharesForSale, market, false)
-			}
-		} else {
-			proceedsFromSale += books[maxProceedsIndex].CloseLongFillOnly(sharesForSale, market, false)
-			proceedsFromSale += books[maxProceedsIndex].CloseShortFillOnly(sharesForSale, market, false)
+		var increment *IncrementTrace
+		if trace != nil {
+			increment = newIncrementTrace(shares, StrategySingleBook, i)
+		}
+		proceedsFromSale := new(big.Rat)
+		proceedsFromSale.Add(proceedsFromSale, closeFillOnly(books, i, shares, market, false, increment))
+		proceedsFromSale.Add(proceedsFromSale, closeFillOnly(books, i, shares, market, true, increment))
+		totalProceeds.Add(totalProceeds, proceedsFromSale)
+		completeSets.Sub(completeSets, shares)
+		if trace != nil {
+			increment.Proceeds.Set(proceedsFromSale)
+			increment.RemainingCompleteSets.Set(completeSets)
+			trace.Increments = append(trace.Increments, increment)
 		}
-		totalProceeds += proceedsFromSale
-		completeSets -= sharesForSale
 	}
 
-	return totalProceeds / allowance.Float64()
+	if trace != nil {
+		trace.Proceeds.Set(totalProceeds)
+	}
+	return totalProceeds.Quo(totalProceeds, exactAllowance)
+}
+
+func newIncrementTrace(shares *big.Rat, strategy Strategy, book int) *IncrementTrace {
+	return &IncrementTrace{
+		Shares:                new(big.Rat).Set(shares),
+		Strategy:              strategy,
+		Book:                  book,
+		Fills:                 []*Fill{},
+		Proceeds:              new(big.Rat),
+		RemainingCompleteSets: new(big.Rat),
+	}
 }
 
-// isMateriallyGreater returns true if and only if `a` is materially greater than `b`. Ie. false if a < b or they are immaterially similar (for our purposes).
-func isMateriallyGreater(a, b float64) bool {
-	return (a - b) > 0.001
+// netOfFees deducts the settlement fee of the market from the proceeds.
+// Every fill is assumed to settle complete sets, which overstates the fees
+// paid when the maker of an order is opening a position.
+func netOfFees(proceeds *big.Rat, market MarketData) *big.Rat {
+	if market.SettlementFee == nil || market.SettlementFee.Sign() == 0 {
+		return proceeds
+	}
+	kept := new(big.Rat).Sub(big.NewRat(1, 1), market.SettlementFee)
+	return proceeds.Mul(proceeds, kept)
+}
+
+// closeFillOnly sells the shares into the bids of books[i], or buys them back
+// from its asks when closing a short, and records the levels taken in the
+// increment unless it is nil. The proceeds are net of fees.
+func closeFillOnly(books []OutcomeOrderBook, i int, shares *big.Rat, market MarketData, closingShort bool, increment *IncrementTrace) *big.Rat {
+	if increment == nil {
+		if closingShort {
+			return netOfFees(books[i].CloseShortFillOnly(shares, market, false), market)
+		}
+		return netOfFees(books[i].CloseLongFillOnly(shares, market, false), market)
+	}
+
+	sideLevels := func() []*Level {
+		bids, asks := books[i].ExactLevels()
+		if closingShort {
+			return asks
+		}
+		return bids
+	}
+	before := sideLevels()
+	var proceeds *big.Rat
+	if closingShort {
+		proceeds = books[i].CloseShortFillOnly(shares, market, false)
+	} else {
+		proceeds = books[i].CloseLongFillOnly(shares, market, false)
+	}
+	proceeds = netOfFees(proceeds, market)
+	increment.Fills = append(increment.Fills, newFill(i, closingShort, proceeds, before, sideLevels()))
+	return proceeds
 }
@@ -2,6 +2,8 @@ package liquidity
 
 import (
 	"math"
+	"math/big"
+	"math/rand"
 	"testing"
 
 	"github.com/stateshape/augur-analyzer/pkg/currency"
@@ -22,8 +24,8 @@ func assertWithinEpsilon(t *testing.T, expected, actual float64) {
 func TestCalculatorGetLiquidityRetentionRatio(t *testing.T) {
 	book := func() *outcomeOrderBook {
 		return &outcomeOrderBook{
-			Bids: []*markets.LiquidityAtPrice{},
-			Asks: []*markets.LiquidityAtPrice{},
+			Bids: []*Level{},
+			Asks: []*Level{},
 		}
 	}
 	book1 := func() []*outcomeOrderBook {
@@ -32,10 +34,10 @@ func TestCalculatorGetLiquidityRetentionRatio(t *testing.T) {
 	book4 := func() []*outcomeOrderBook {
 		return []*outcomeOrderBook{book(), book(), book(), book()}
 	}
-	toLap := func(price, amount float32) *markets.LiquidityAtPrice {
-		return &markets.LiquidityAtPrice{
-			Price:  price,
-			Amount: amount,
+	toLevel := func(price, amount float32) *Level {
+		return &Level{
+			Price:  DecimalFromFloat32(price),
+			Amount: DecimalFromFloat32(amount),
 		}
 	}
 	type lap struct {
@@ -44,13 +46,13 @@ func TestCalculatorGetLiquidityRetentionRatio(t *testing.T) {
 	}
 	bids := func(oobs []*outcomeOrderBook, bookIndex uint64, laps ...lap) []*outcomeOrderBook {
 		for _, l := range laps {
-			oobs[bookIndex].Bids = append(oobs[bookIndex].Bids, toLap(l.Price, l.Amount))
+			oobs[bookIndex].Bids = append(oobs[bookIndex].Bids, toLevel(l.Price, l.Amount))
 		}
 		return oobs
 	}
 	asks := func(oobs []*outcomeOrderBook, bookIndex uint64, laps ...lap) []*outcomeOrderBook {
 		for _, l := range laps {
-			oobs[bookIndex].Asks = append(oobs[bookIndex].Asks, toLap(l.Price, l.Amount))
+			oobs[bookIndex].Asks = append(oobs[bookIndex].Asks, toLevel(l.Price, l.Amount))
 		}
 		return oobs
 	}
@@ -66,16 +68,17 @@ func TestCalculatorGetLiquidityRetentionRatio(t *testing.T) {
 		return oobs2
 	}
 
-	shareSellingIncrement := 0.01
+	// Complete sets sold at a time by the greedy liquidation
+	shareSellingIncrement := big.NewRat(1, 100)
 	allowance := currency.Ether(5)
 
-	marketDataForYesNoAndCategoricalMarkets := MarketData{MinPrice: 0.0, MaxPrice: 1.0}
+	marketDataForYesNoAndCategoricalMarkets := MarketData{MinPrice: big.NewRat(0, 1), MaxPrice: big.NewRat(1, 1)}
 	md := marketDataForYesNoAndCategoricalMarkets // alias for anti-spam
 
-	marketDataForScalarMarkets := MarketData{MinPrice: -100, MaxPrice: 1200.0}
+	marketDataForScalarMarkets := MarketData{MinPrice: big.NewRat(-100, 1), MaxPrice: big.NewRat(1200, 1)}
 	mdScalar := marketDataForScalarMarkets
 
-	scalarCompleteSets := allowance.Float64() / (mdScalar.MaxPrice - mdScalar.MinPrice)
+	scalarCompleteSets := allowance.Float64() / 1300
 
 	defaultCompleteSetsForYesNoAndCategoricalMarkets := float32(allowance.Float64())
 	cs := defaultCompleteSetsForYesNoAndCategoricalMarkets // alias for anti-spam
@@ -84,33 +87,32 @@ func TestCalculatorGetLiquidityRetentionRatio(t *testing.T) {
 		Name                   string
 		OutcomeOrderBooks      []*outcomeOrderBook
 		Market                 MarketData
-		ShareSellingIncrement  float64
 		Allowance              currency.Ether
 		ExpectedRetentionRatio float64
 	}{
-		{Name: "Yes/No", OutcomeOrderBooks: []*outcomeOrderBook{NewOutcomeOrderBook([]*markets.LiquidityAtPrice{{Price: .5, Amount: 2}, {Price: .45, Amount: 2}, {Price: .4, Amount: 2}, {Price: .35, Amount: 2}, {Price: .3, Amount: 2}}, []*markets.LiquidityAtPrice{{Price: .6, Amount: 2}, {Price: .65, Amount: 2}, {Price: .7, Amount: 2}, {Price: .75, Amount: 2}, {Price: .8, Amount: 2}}).(*outcomeOrderBook)}, Market: MarketData{MinPrice: 0.0, MaxPrice: 1.0}, ShareSellingIncrement: 0.01, Allowance: currency.Ether(5), ExpectedRetentionRatio: 0.82},
-		{"Yes/No - perfect liquidity #1", orders(book1(), 0, []lap{lap{0.5, cs}}, []lap{lap{0.5, cs}}), md, shareSellingIncrement, allowance, 1},
-		{"Scalar - perfect liquidity #1", orders(book1(), 0, []lap{lap{585, cs}}, []lap{lap{585, cs}}), mdScalar, shareSellingIncrement, allowance, 1},
-		{"Categorical - perfect liquidity #1", orders(orders(book4(), 1, []lap{lap{0.5, 2.5}}, []lap{lap{0.5, 2.5}}), 2, []lap{lap{0.5, 5}}, []lap{lap{0.5, 5}}), md, shareSellingIncrement, allowance, 1},
-		{"Yes/No - perfect liquidity #2", orders(book1(), 0, []lap{lap{0.115, cs * 2}}, []lap{lap{0.115, cs * 1.5}}), md, shareSellingIncrement, allowance, 1},
-		{"Scalar - perfect liquidity #2", orders(book1(), 0, []lap{lap{-28, cs}}, []lap{lap{-28, cs * 10}}), mdScalar, shareSellingIncrement, allowance, 1},
-		{"Categorical - perfect liquidity #2", orders(orders(book4(), 1, []lap{lap{0.4, 2}}, []lap{lap{0.4, 2}}), 2, []lap{lap{0.6, 3}}, []lap{lap{0.6, 3}}), md, shareSellingIncrement, allowance, 1},
-		{"Categorical - perfect liquidity #3", bids(bids(bids(bids(book4(), 0, lap{0.4, 5}), 1, lap{0.2, 5}), 2, lap{0.15, 5}), 3, lap{0.25, 5}), md, shareSellingIncrement, allowance, 1},
-		{"Categorical - perfect liquidity #4", bids(bids(bids(bids(book4(), 0, lap{0.45, 4}, lap{0.35, 1}), 1, lap{0.2, 4}, lap{0.15, 1}), 2, lap{0.15, 4}, lap{0.10, 1}), 3, lap{0.25, 4}, lap{0.20, 4}), md, shareSellingIncrement, allowance, 1},
-		{"Categorical - perfect liquidity #5", asks(bids(bids(bids(bids(book4(), 0, lap{0.4, 3}), 1, lap{0.2, 3}), 2, lap{0.15, 3}), 3, lap{0.25, 3}, lap{0.2, 2}), 3, lap{0.2, 2}), md, shareSellingIncrement, allowance, 1},
-		{"YesNo - no liquidity", book1(), md, shareSellingIncrement, allowance, 0},
-		{"Scalar - no liquidity", book1(), mdScalar, shareSellingIncrement, allowance, 0},
-		{"Categorical - no liquidity", book4(), md, shareSellingIncrement, allowance, 0},
-		{"Yes/No - low liquidity due to wide spread", orders(book1(), 0, []lap{lap{0.2, cs * 10}}, []lap{lap{0.6, cs * 25}}), md, shareSellingIncrement, allowance, 0.6},
-		{"Scalar - low liquidity due to wide spread", orders(book1(), 0, []lap{lap{0, cs * 123}}, []lap{lap{500, cs * 25}}), mdScalar, shareSellingIncrement, allowance, 800.0 / 1300}, // ie. share price is 1300, we get 100 from closing long and (1200-500 = 700) from closing short, ratio is 800/1300
-
-		{"Yes/No - low liquidity due to insufficient quantity", orders(book1(), 0, []lap{lap{0.5, cs * 0.25}}, []lap{lap{0.5, cs * 0.5}}), md, shareSellingIncrement, allowance, 0.25 + 0.5*0.25}, // 100% price on 25% of shares, 50% on next 25% of shares, 0% on last 50% of shares (this arithmetic only works because it's at 0.5 price halfway between max and min)
-
-		{"Scalar - low liquidity due to insufficient quantity", orders(book1(), 0, []lap{lap{800, float32(scalarCompleteSets / 3.0)}}, []lap{lap{800, float32(scalarCompleteSets / 3.0 * 2)}}), mdScalar, shareSellingIncrement, allowance, 1.0/3 + 400.0/(1300*3)}, // 100% of price on 33% of shares + (400/1300) on next third of shares
-		{"Categorical - low liquidity due to wide spread #1", orders(orders(book4(), 1, []lap{lap{0.4, 2.5}}, []lap{lap{0.5, 2.5}}), 2, []lap{lap{0.4, 5}}, []lap{lap{0.5, 5}}), md, shareSellingIncrement, allowance, 0.9},
-		{"Categorical - low liquidity due to wide spread #2", asks(bids(bids(bids(bids(book4(), 0, lap{0.4, 3}), 1, lap{0.2, 3}), 2, lap{0.15, 3}), 3, lap{0.25, 3}, lap{0.2, 2}), 3, lap{0.6, 2}), md, shareSellingIncrement, allowance, 4.2 / 5},
-		{"Categorical - low liquidity due to insufficient quantity", orders(orders(book4(), 1, []lap{lap{0.6, 2.5}}, []lap{lap{0.6, 2.5}}), 2, []lap{lap{0.4, 1.5}}, []lap{lap{0.4, 1.5}}), md, shareSellingIncrement, allowance, 0.8},
-		{"Categorical - low liquidity due to wide spread and insufficient quantity", asks(bids(bids(bids(bids(book4(), 0, lap{0.4, 3}), 1, lap{0.2, 3}), 2, lap{0.15, 3}), 3, lap{0.25, 3}, lap{0.15, 1}), 3, lap{0.65, 1}), md, shareSellingIncrement, allowance, 0.7},
+		{Name: "Yes/No", OutcomeOrderBooks: []*outcomeOrderBook{NewOutcomeOrderBook([]*markets.LiquidityAtPrice{{Price: .5, Amount: 2}, {Price: .45, Amount: 2}, {Price: .4, Amount: 2}, {Price: .35, Amount: 2}, {Price: .3, Amount: 2}}, []*markets.LiquidityAtPrice{{Price: .6, Amount: 2}, {Price: .65, Amount: 2}, {Price: .7, Amount: 2}, {Price: .75, Amount: 2}, {Price: .8, Amount: 2}}).(*outcomeOrderBook)}, Market: md, Allowance: currency.Ether(5), ExpectedRetentionRatio: 0.82},
+		{"Yes/No - perfect liquidity #1", orders(book1(), 0, []lap{lap{0.5, cs}}, []lap{lap{0.5, cs}}), md, allowance, 1},
+		{"Scalar - perfect liquidity #1", orders(book1(), 0, []lap{lap{585, cs}}, []lap{lap{585, cs}}), mdScalar, allowance, 1},
+		{"Categorical - perfect liquidity #1", orders(orders(book4(), 1, []lap{lap{0.5, 2.5}}, []lap{lap{0.5, 2.5}}), 2, []lap{lap{0.5, 5}}, []lap{lap{0.5, 5}}), md, allowance, 1},
+		{"Yes/No - perfect liquidity #2", orders(book1(), 0, []lap{lap{0.115, cs * 2}}, []lap{lap{0.115, cs * 1.5}}), md, allowance, 1},
+		{"Scalar - perfect liquidity #2", orders(book1(), 0, []lap{lap{-28, cs}}, []lap{lap{-28, cs * 10}}), mdScalar, allowance, 1},
+		{"Categorical - perfect liquidity #2", orders(orders(book4(), 1, []lap{lap{0.4, 2}}, []lap{lap{0.4, 2}}), 2, []lap{lap{0.6, 3}}, []lap{lap{0.6, 3}}), md, allowance, 1},
+		{"Categorical - perfect liquidity #3", bids(bids(bids(bids(book4(), 0, lap{0.4, 5}), 1, lap{0.2, 5}), 2, lap{0.15, 5}), 3, lap{0.25, 5}), md, allowance, 1},
+		{"Categorical - perfect liquidity #4", bids(bids(bids(bids(book4(), 0, lap{0.45, 4}, lap{0.35, 1}), 1, lap{0.2, 4}, lap{0.15, 1}), 2, lap{0.15, 4}, lap{0.10, 1}), 3, lap{0.25, 4}, lap{0.20, 4}), md, allowance, 1},
+		{"Categorical - perfect liquidity #5", asks(bids(bids(bids(bids(book4(), 0, lap{0.4, 3}), 1, lap{0.2, 3}), 2, lap{0.15, 3}), 3, lap{0.25, 3}, lap{0.2, 2}), 3, lap{0.2, 2}), md, allowance, 1},
+		{"YesNo - no liquidity", book1(), md, allowance, 0},
+		{"Scalar - no liquidity", book1(), mdScalar, allowance, 0},
+		{"Categorical - no liquidity", book4(), md, allowance, 0},
+		{"Yes/No - low liquidity due to wide spread", orders(book1(), 0, []lap{lap{0.2, cs * 10}}, []lap{lap{0.6, cs * 25}}), md, allowance, 0.6},
+		{"Scalar - low liquidity due to wide spread", orders(book1(), 0, []lap{lap{0, cs * 123}}, []lap{lap{500, cs * 25}}), mdScalar, allowance, 800.0 / 1300}, // ie. share price is 1300, we get 100 from closing long and (1200-500 = 700) from closing short, ratio is 800/1300
+
+		{"Yes/No - low liquidity due to insufficient quantity", orders(book1(), 0, []lap{lap{0.5, cs * 0.25}}, []lap{lap{0.5, cs * 0.5}}), md, allowance, 0.25 + 0.5*0.25}, // 100% price on 25% of shares, 50% on next 25% of shares, 0% on last 50% of shares (this arithmetic only works because it's at 0.5 price halfway between max and min)
+
+		{"Scalar - low liquidity due to insufficient quantity", orders(book1(), 0, []lap{lap{800, float32(scalarCompleteSets / 3.0)}}, []lap{lap{800, float32(scalarCompleteSets / 3.0 * 2)}}), mdScalar, allowance, 1.0/3 + 400.0/(1300*3)}, // 100% of price on 33% of shares + (400/1300) on next third of shares
+		{"Categorical - low liquidity due to wide spread #1", orders(orders(book4(), 1, []lap{lap{0.4, 2.5}}, []lap{lap{0.5, 2.5}}), 2, []lap{lap{0.4, 5}}, []lap{lap{0.5, 5}}), md, allowance, 0.9},
+		{"Categorical - low liquidity due to wide spread #2", asks(bids(bids(bids(bids(book4(), 0, lap{0.4, 3}), 1, lap{0.2, 3}), 2, lap{0.15, 3}), 3, lap{0.25, 3}, lap{0.2, 2}), 3, lap{0.6, 2}), md, allowance, 4.2 / 5},
+		{"Categorical - low liquidity due to insufficient quantity", orders(orders(book4(), 1, []lap{lap{0.6, 2.5}}, []lap{lap{0.6, 2.5}}), 2, []lap{lap{0.4, 1.5}}, []lap{lap{0.4, 1.5}}), md, allowance, 0.8},
+		{"Categorical - low liquidity due to wide spread and insufficient quantity", asks(bids(bids(bids(bids(book4(), 0, lap{0.4, 3}), 1, lap{0.2, 3}), 2, lap{0.15, 3}), 3, lap{0.25, 3}, lap{0.15, 1}), 3, lap{0.65, 1}), md, allowance, 0.7},
 
 		/*
 			TODO more test cases
@@ -130,11 +132,191 @@ func TestCalculatorGetLiquidityRetentionRatio(t *testing.T) {
 	testsRun := 0
 	for _, c := range cases {
 		t.Run(c.Name, func(t *testing.T) {
-			rr := calculator.GetLiquidityRetentionRatio(c.ShareSellingIncrement, c.Allowance, c.Market, toI(c.OutcomeOrderBooks))
+			books := toI(c.OutcomeOrderBooks)
+			clones := make([]OutcomeOrderBook, len(books))
+			for i, book := range books {
+				clones[i] = book.DeepClone()
+			}
+			rr, _ := calculator.GetLiquidityRetentionRatio(c.Allowance, c.Market, books).Float64()
 			assertWithinEpsilon(t, c.ExpectedRetentionRatio, rr)
+			greedy, _ := greedyLiquidityRetentionRatio(shareSellingIncrement, c.Allowance, c.Market, clones).Float64()
+			assertWithinEpsilon(t, greedy, rr)
 			// assert.True(t, withinEpsilon(c.ExpectedRetentionRatio, rr))
 			testsRun++
 		})
 	}
 	assert.Equal(t, len(cases), testsRun, "sanity check that all tests ran")
 }
+
+func TestCalculatorSettlementFee(t *testing.T) {
+	books := func() []OutcomeOrderBook {
+		return []OutcomeOrderBook{
+			NewOutcomeOrderBook(
+				[]*markets.LiquidityAtPrice{{Price: 0.4, Amount: 1}},
+				[]*markets.LiquidityAtPrice{{Price: 0.5, Amount: 1}},
+			),
+		}
+	}
+	calculator := NewCalculator()
+	market := MarketData{MinPrice: big.NewRat(0, 1), MaxPrice: big.NewRat(1, 1)}
+	gross := calculator.GetLiquidityRetentionRatio(currency.Ether(1), market, books())
+	assert.Equal(t, "9/10", gross.RatString())
+
+	market.SettlementFee = big.NewRat(1, 50)
+	net := calculator.GetLiquidityRetentionRatio(currency.Ether(1), market, books())
+	assert.Equal(t, "441/500", net.RatString())
+}
+
+// greedyLiquidityRetentionRatio sells complete sets of categorical markets an
+// increment at a time, each into the book, or into each book, yielding the
+// most proceeds for the increment. It is the reference the exact liquidation
+// is compared against.
+func greedyLiquidityRetentionRatio(sellingIncrement *big.Rat, allowance currency.Ether, market MarketData, books []OutcomeOrderBook) *big.Rat {
+	exactAllowance := DecimalFromFloat(allowance.Float64())
+	priceRange := new(big.Rat).Sub(market.MaxPrice, market.MinPrice)
+	if exactAllowance.Sign() <= 0 || priceRange.Sign() <= 0 || len(books) == 0 {
+		return new(big.Rat)
+	}
+	completeSets := new(big.Rat).Quo(exactAllowance, priceRange)
+	totalProceeds := new(big.Rat)
+	if len(books) < 2 {
+		totalProceeds.Add(totalProceeds, closeFillOnly(books, 0, completeSets, market, false, nil))
+		totalProceeds.Add(totalProceeds, closeFillOnly(books, 0, completeSets, market, true, nil))
+		return totalProceeds.Quo(totalProceeds, exactAllowance)
+	}
+
+	for completeSets.Sign() > 0 {
+		sharesForSale := minRat(sellingIncrement, completeSets)
+		estimatedProceeds := make([]*big.Rat, len(books)+1)
+		for i := range estimatedProceeds {
+			estimatedProceeds[i] = new(big.Rat)
+		}
+		for i := 0; i < len(books); i++ {
+			longProceeds := netOfFees(books[i].CloseLongFillOnly(sharesForSale, market, true), market)
+			estimatedProceeds[len(books)].Add(estimatedProceeds[len(books)], longProceeds)
+			estimatedProceeds[i].Add(estimatedProceeds[i], longProceeds)
+			estimatedProceeds[i].Add(estimatedProceeds[i], netOfFees(books[i].CloseShortFillOnly(sharesForSale, market, true), market))
+		}
+		maxProceeds := new(big.Rat)
+		maxProceedsIndex := 0
+		for i := 0; i < len(estimatedProceeds); i++ {
+			if estimatedProceeds[i].Cmp(maxProceeds) > 0 {
+				maxProceeds = estimatedProceeds[i]
+				maxProceedsIndex = i
+			}
+		}
+		if maxProceeds.Sign() == 0 {
+			break
+		}
+		if maxProceedsIndex == len(books) {
+			for i := 0; i < len(books); i++ {
+				totalProceeds.Add(totalProceeds, closeFillOnly(books, i, sharesForSale, market, false, nil))
+			}
+		} else {
+			totalProceeds.Add(totalProceeds, closeFillOnly(books, maxProceedsIndex, sharesForSale, market, false, nil))
+			totalProceeds.Add(totalProceeds, closeFillOnly(books, maxProceedsIndex, sharesForSale, market, true, nil))
+		}
+		completeSets.Sub(completeSets, sharesForSale)
+	}
+	return totalProceeds.Quo(totalProceeds, exactAllowance)
+}
+
+func cloneBooks(books []OutcomeOrderBook) []OutcomeOrderBook {
+	clones := make([]OutcomeOrderBook, len(books))
+	for i, book := range books {
+		clones[i] = book.DeepClone()
+	}
+	return clones
+}
+
+// randomBooks creates books of a market priced from 0 to 1 with up to depth
+// levels on each side, priced and sized in hundredths
+func randomBooks(r *rand.Rand, outcomes, depth int) []OutcomeOrderBook {
+	books := make([]OutcomeOrderBook, outcomes)
+	for i := range books {
+		mid := int64(10 + r.Intn(80))
+		bids, asks := []*Level{}, []*Level{}
+		for price := mid; price > 0 && len(bids) < depth; price -= int64(1 + r.Intn(5)) {
+			bids = append(bids, &Level{Price: big.NewRat(price, 100), Amount: big.NewRat(int64(1+r.Intn(300)), 100)})
+		}
+		for price := mid + int64(1+r.Intn(5)); price < 100 && len(asks) < depth; price += int64(1 + r.Intn(5)) {
+			asks = append(asks, &Level{Price: big.NewRat(price, 100), Amount: big.NewRat(int64(1+r.Intn(300)), 100)})
+		}
+		books[i] = NewExactOutcomeOrderBook(bids, asks)
+	}
+	return books
+}
+
+func TestCalculatorExactLiquidation(t *testing.T) {
+	market := MarketData{MinPrice: big.NewRat(0, 1), MaxPrice: big.NewRat(1, 1)}
+	calculator := NewCalculator()
+
+	t.Run("Beats greedy", func(t *testing.T) {
+		// Selling into each book yields 1 a set for the first set, after which
+		// the bids are gone and a set only yields the 0.49 of its short.
+		// Selling into a single book yields 0.99 a set for both sets.
+		books := func() []OutcomeOrderBook {
+			book := func() OutcomeOrderBook {
+				return NewOutcomeOrderBook(
+					[]*markets.LiquidityAtPrice{{Price: 0.5, Amount: 1}},
+					[]*markets.LiquidityAtPrice{{Price: 0.51, Amount: 100}},
+				)
+			}
+			return []OutcomeOrderBook{book(), book()}
+		}
+		assert.Equal(t, "149/200", greedyLiquidityRetentionRatio(big.NewRat(1, 100), currency.Ether(2), market, books()).RatString())
+		assert.Equal(t, "99/100", calculator.GetLiquidityRetentionRatio(currency.Ether(2), market, books()).RatString())
+	})
+
+	t.Run("Random books", func(t *testing.T) {
+		r := rand.New(rand.NewSource(49))
+		for n := 0; n < 100; n++ {
+			books := randomBooks(r, 2+r.Intn(4), 1+r.Intn(6))
+			allowance := currency.Ether(float64(1+r.Intn(1000)) / 100)
+			exactAllowance := DecimalFromFloat(allowance.Float64())
+
+			// The proceeds executed are the ones of the best liquidation
+			l := newLiquidation(books, market, exactAllowance)
+			best, _, _ := l.Solve(l.Best())
+			for e := 0; e <= 20; e++ {
+				sets := new(big.Rat).Mul(exactAllowance, big.NewRat(int64(e), 20))
+				proceeds, _, _ := l.Solve(sets)
+				assert.True(t, proceeds.Cmp(best) <= 0, "better liquidation selling %s sets into each book", sets.FloatString(4))
+			}
+			greedy := greedyLiquidityRetentionRatio(big.NewRat(1, 100), allowance, market, cloneBooks(books))
+			rr := calculator.GetLiquidityRetentionRatio(allowance, market, books)
+			assert.Equal(t, new(big.Rat).Quo(best, exactAllowance).RatString(), rr.RatString())
+			assert.True(t, rr.Cmp(greedy) >= 0, "greedy %s beats exact %s", greedy.FloatString(6), rr.FloatString(6))
+		}
+	})
+}
+
+// deepBooks are the books of a categorical market with 5 outcomes and 50
+// levels on each side of every book
+func deepBooks() []OutcomeOrderBook {
+	books := make([]OutcomeOrderBook, 5)
+	for i := range books {
+		bids, asks := []*Level{}, []*Level{}
+		for level := int64(0); level < 50; level++ {
+			bids = append(bids, &Level{Price: big.NewRat(60-level, 300), Amount: big.NewRat(10+level, 1)})
+			asks = append(asks, &Level{Price: big.NewRat(61+level, 300), Amount: big.NewRat(10+level, 1)})
+		}
+		books[i] = NewExactOutcomeOrderBook(bids, asks)
+	}
+	return books
+}
+
+func BenchmarkCalculatorGetLiquidityRetentionRatio(b *testing.B) {
+	market := MarketData{MinPrice: big.NewRat(0, 1), MaxPrice: big.NewRat(1, 1)}
+	calculator := NewCalculator()
+	for n := 0; n < b.N; n++ {
+		calculator.GetLiquidityRetentionRatio(currency.Ether(250), market, deepBooks())
+	}
+}
+
+func BenchmarkGreedyLiquidityRetentionRatio(b *testing.B) {
+	market := MarketData{MinPrice: big.NewRat(0, 1), MaxPrice: big.NewRat(1, 1)}
+	for n := 0; n < b.N; n++ {
+		greedyLiquidityRetentionRatio(big.NewRat(1, 100), currency.Ether(250), market, deepBooks())
+	}
+}
@@ -0,0 +1,85 @@
+package liquidity
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/stateshape/augur-analyzer/pkg/proto/markets"
+)
+
+// Level is the liquidity at a price of an order book, with the price and the
+// amount of shares held as exact rationals. Converting to floats only happens
+// when levels are written to protos.
+type Level struct {
+	Price  *big.Rat
+	Amount *big.Rat
+	// Orders aggregated at the price, zero when unknown
+	Orders uint64
+}
+
+func (l *Level) clone() *Level {
+	return &Level{
+		Price:  new(big.Rat).Set(l.Price),
+		Amount: new(big.Rat).Set(l.Amount),
+		Orders: l.Orders,
+	}
+}
+
+// ParseDecimal parses a decimal string, such as the full precision price or
+// amount of an order, into an exact rational
+func ParseDecimal(s string) (*big.Rat, error) {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, fmt.Errorf("Failed to parse decimal %q", s)
+	}
+	return r, nil
+}
+
+// DecimalFromFloat returns the shortest decimal which rounds to the float,
+// e.g. 0.1 rather than the binary value 0.1000000000000000055511151231257827
+func DecimalFromFloat(f float64) *big.Rat {
+	r, _ := new(big.Rat).SetString(strconv.FormatFloat(f, 'g', -1, 64))
+	return r
+}
+
+// DecimalFromFloat32 is DecimalFromFloat for the float32 prices and amounts
+// of protos
+func DecimalFromFloat32(f float32) *big.Rat {
+	r, _ := new(big.Rat).SetString(strconv.FormatFloat(float64(f), 'g', -1, 32))
+	return r
+}
+
+// LevelsFromLiquidityAtPrice converts the levels of a proto order book
+func LevelsFromLiquidityAtPrice(laps []*markets.LiquidityAtPrice) []*Level {
+	levels := make([]*Level, len(laps))
+	for i, lap := range laps {
+		levels[i] = &Level{
+			Price:  DecimalFromFloat32(lap.Price),
+			Amount: DecimalFromFloat32(lap.Amount),
+		}
+	}
+	return levels
+}
+
+// LiquidityAtPriceFromLevels converts levels into the levels of a proto
+// order book
+func LiquidityAtPriceFromLevels(levels []*Level) []*markets.LiquidityAtPrice {
+	laps := make([]*markets.LiquidityAtPrice, len(levels))
+	for i, level := range levels {
+		price, _ := level.Price.Float32()
+		amount, _ := level.Amount.Float32()
+		laps[i] = &markets.LiquidityAtPrice{
+			Price:  price,
+			Amount: amount,
+		}
+	}
+	return laps
+}
+
+func minRat(a, b *big.Rat) *big.Rat {
+	if a.Cmp(b) < 0 {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,67 @@
+package liquidity
+
+import (
+	"math/big"
+
+	"github.com/stateshape/augur-analyzer/pkg/currency"
+)
+
+// EntryCost is the result of spending an allowance on entering a position by
+// taking an order book
+type EntryCost struct {
+	Shares       *big.Rat
+	AveragePrice *big.Rat
+	// Distance from the best price to the average price, as a fraction of
+	// the price range of the market
+	Slippage *big.Rat
+	// Fraction of the allowance the order book could absorb
+	Filled *big.Rat
+	// Orders taken
+	Fills uint64
+}
+
+// GetEntryCost spends the allowance on buying the outcome of the book, or on
+// shorting it, on a clone of the book. Returns nil if no share can be taken.
+func GetEntryCost(book OutcomeOrderBook, allowance currency.Ether, market MarketData, short bool) *EntryCost {
+	exactAllowance := DecimalFromFloat(allowance.Float64())
+	priceRange := new(big.Rat).Sub(market.MaxPrice, market.MinPrice)
+	if exactAllowance.Sign() <= 0 || priceRange.Sign() <= 0 {
+		return nil
+	}
+
+	bids, asks := book.ExactLevels()
+	clone := book.DeepClone()
+	var shares, cost *big.Rat
+	var best *Level
+	if short {
+		shares, cost = clone.OpenShortFillOnly(exactAllowance, market, false)
+		if len(bids) > 0 {
+			best = bids[0]
+		}
+	} else {
+		shares, cost = clone.OpenLongFillOnly(exactAllowance, market, false)
+		if len(asks) > 0 {
+			best = asks[0]
+		}
+	}
+	if best == nil || shares.Sign() <= 0 {
+		return nil
+	}
+
+	// The cost per share is the average price less the min price when
+	// buying, and the max price less the average price when shorting
+	costPerShare := new(big.Rat).Quo(cost, shares)
+	averagePrice := new(big.Rat).Add(market.MinPrice, costPerShare)
+	slippage := new(big.Rat).Sub(averagePrice, best.Price)
+	if short {
+		averagePrice.Sub(market.MaxPrice, costPerShare)
+		slippage.Sub(best.Price, averagePrice)
+	}
+	return &EntryCost{
+		Shares:       shares,
+		AveragePrice: averagePrice,
+		Slippage:     slippage.Quo(slippage, priceRange),
+		Filled:       new(big.Rat).Quo(cost, exactAllowance),
+		Fills:        CountFills(book, clone),
+	}
+}
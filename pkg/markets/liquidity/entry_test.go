@@ -0,0 +1,51 @@
+package liquidity_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stateshape/augur-analyzer/pkg/currency"
+	"github.com/stateshape/augur-analyzer/pkg/markets/liquidity"
+	"github.com/stateshape/augur-analyzer/pkg/proto/markets"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEntryCost(t *testing.T) {
+	market := liquidity.MarketData{MinPrice: big.NewRat(0, 1), MaxPrice: big.NewRat(1, 1)}
+	book := liquidity.NewOutcomeOrderBook(
+		[]*markets.LiquidityAtPrice{{Price: 0.4, Amount: 5}},
+		[]*markets.LiquidityAtPrice{{Price: 0.6, Amount: 2}, {Price: 0.7, Amount: 10}},
+	)
+
+	t.Run("Buy across levels", func(t *testing.T) {
+		// 2 shares at 0.6 cost 1.2, the remaining 0.8 buys 8/7 shares at 0.7
+		cost := liquidity.GetEntryCost(book, currency.Ether(2), market, false)
+		assert.Equal(t, "22/7", cost.Shares.RatString())
+		assert.Equal(t, "7/11", cost.AveragePrice.RatString())
+		assert.Equal(t, "2/55", cost.Slippage.RatString())
+		assert.Equal(t, "1", cost.Filled.RatString())
+	})
+
+	t.Run("Short within the best bid", func(t *testing.T) {
+		cost := liquidity.GetEntryCost(book, currency.Ether(2), market, true)
+		assert.Equal(t, "10/3", cost.Shares.RatString())
+		assert.Equal(t, "2/5", cost.AveragePrice.RatString())
+		assert.Equal(t, 0, cost.Slippage.Sign())
+	})
+
+	t.Run("Insufficient liquidity", func(t *testing.T) {
+		cost := liquidity.GetEntryCost(book, currency.Ether(10), market, false)
+		assert.Equal(t, "12", cost.Shares.RatString())
+		assert.Equal(t, "41/50", cost.Filled.RatString())
+	})
+
+	t.Run("Empty side", func(t *testing.T) {
+		empty := liquidity.NewOutcomeOrderBook(nil, []*markets.LiquidityAtPrice{{Price: 0.6, Amount: 2}})
+		assert.Nil(t, liquidity.GetEntryCost(empty, currency.Ether(1), market, true))
+	})
+
+	// The book itself is left untouched
+	_, asks := book.ExactLevels()
+	assert.Equal(t, "2", asks[0].Amount.RatString())
+}
@@ -0,0 +1,43 @@
+package liquidity
+
+import (
+	"math/big"
+)
+
+// CountFills returns the number of orders taken from a book to reach the
+// state of the book after. Levels of unknown orders count as one order, and
+// partially taken levels count the share of their orders taken, rounded up.
+func CountFills(before, after OutcomeOrderBook) uint64 {
+	bidsBefore, asksBefore := before.ExactLevels()
+	bidsAfter, asksAfter := after.ExactLevels()
+	return countFills(bidsBefore, bidsAfter) + countFills(asksBefore, asksAfter)
+}
+
+func countFills(before, after []*Level) uint64 {
+	fills := uint64(0)
+	// The levels left are the last levels of the book before
+	taken := len(before) - len(after)
+	for i, level := range before {
+		orders := level.Orders
+		if orders == 0 {
+			orders = 1
+		}
+		if i < taken {
+			fills += orders
+			continue
+		}
+		amount := new(big.Rat).Sub(level.Amount, after[i-taken].Amount)
+		if amount.Sign() <= 0 {
+			break
+		}
+		share := amount.Mul(amount, new(big.Rat).SetInt64(int64(orders)))
+		share.Quo(share, level.Amount)
+		partial := new(big.Int).Quo(share.Num(), share.Denom())
+		if !share.IsInt() {
+			partial.Add(partial, big.NewInt(1))
+		}
+		fills += partial.Uint64()
+		break
+	}
+	return fills
+}
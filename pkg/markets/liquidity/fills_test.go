@@ -0,0 +1,38 @@
+package liquidity_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stateshape/augur-analyzer/pkg/markets/liquidity"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountFills(t *testing.T) {
+	market := liquidity.MarketData{MinPrice: big.NewRat(0, 1), MaxPrice: big.NewRat(1, 1)}
+	book := liquidity.NewExactOutcomeOrderBook(
+		[]*liquidity.Level{
+			{Price: big.NewRat(6, 10), Amount: big.NewRat(2, 1), Orders: 3},
+			{Price: big.NewRat(5, 10), Amount: big.NewRat(4, 1), Orders: 4},
+		},
+		[]*liquidity.Level{
+			// Levels of unknown orders count as one order
+			{Price: big.NewRat(7, 10), Amount: big.NewRat(1, 1)},
+		},
+	)
+
+	clone := book.DeepClone()
+	assert.Equal(t, uint64(0), liquidity.CountFills(book, clone))
+
+	// The whole first level and a quarter of the second one, 3 + 1 orders
+	clone.CloseLongFillOnly(big.NewRat(3, 1), market, false)
+	assert.Equal(t, uint64(4), liquidity.CountFills(book, clone))
+
+	// Half a share more takes 1.5 of the 4 orders of the second level, rounded up
+	clone.CloseLongFillOnly(big.NewRat(1, 2), market, false)
+	assert.Equal(t, uint64(5), liquidity.CountFills(book, clone))
+
+	clone.CloseShortFillOnly(big.NewRat(1, 2), market, false)
+	assert.Equal(t, uint64(6), liquidity.CountFills(book, clone))
+}
@@ -1,20 +1,38 @@
 package liquidity
 
 import (
+	"math/big"
+
 	"github.com/stateshape/augur-analyzer/pkg/currency"
+	"github.com/stateshape/augur-analyzer/pkg/proto/markets"
 )
 
 type OutcomeOrderBook interface {
 	DeepClone() OutcomeOrderBook
-	CloseLongFillOnly(shares float64, market MarketData, dryRun bool) (proceeds float64)
-	CloseShortFillOnly(shares float64, market MarketData, dryRun bool) (proceeds float64)
+	CloseLongFillOnly(shares *big.Rat, market MarketData, dryRun bool) (proceeds *big.Rat)
+	CloseShortFillOnly(shares *big.Rat, market MarketData, dryRun bool) (proceeds *big.Rat)
+	// OpenLongFillOnly takes the asks until the allowance is spent, and
+	// OpenShortFillOnly the bids. The cost of a share is its price less the
+	// min price when buying, and the max price less its price when shorting.
+	OpenLongFillOnly(allowance *big.Rat, market MarketData, dryRun bool) (shares *big.Rat, cost *big.Rat)
+	OpenShortFillOnly(allowance *big.Rat, market MarketData, dryRun bool) (shares *big.Rat, cost *big.Rat)
+	// Levels returns copies of the bids, best first, and of the asks, best first
+	Levels() (bids []*markets.LiquidityAtPrice, asks []*markets.LiquidityAtPrice)
+	// ExactLevels is Levels with exact prices and amounts
+	ExactLevels() (bids []*Level, asks []*Level)
 }
 
 type Calculator interface {
-	GetLiquidityRetentionRatio(sellingIncrement float64, allowance currency.Ether, market MarketData, outcomes []OutcomeOrderBook) (retentionRatio float64)
+	GetLiquidityRetentionRatio(allowance currency.Ether, market MarketData, outcomes []OutcomeOrderBook) (retentionRatio *big.Rat)
+	// TraceLiquidityRetentionRatio is GetLiquidityRetentionRatio recording
+	// the strategy and the levels taken of every increment
+	TraceLiquidityRetentionRatio(allowance currency.Ether, market MarketData, outcomes []OutcomeOrderBook) (retentionRatio *big.Rat, trace *RetentionTrace)
 }
 
 type MarketData struct {
-	MinPrice float64
-	MaxPrice float64
+	MinPrice *big.Rat
+	MaxPrice *big.Rat
+	// Fraction of the proceeds of closing a position paid in settlement
+	// fees, nil when fees are not modeled
+	SettlementFee *big.Rat
 }
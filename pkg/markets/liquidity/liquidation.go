@@ -0,0 +1,218 @@
+package liquidity
+
+import (
+	"math/big"
+	"sort"
+)
+
+// depthLevel is a level of one side of a book, with the depth of the side up
+// to the end of the level and the proceeds of taking a share of the level
+type depthLevel struct {
+	End   *big.Rat
+	Value *big.Rat
+}
+
+// liquidationStep is a stretch of the complete sets sold into a single book
+// over which every set yields the same proceeds
+type liquidationStep struct {
+	Book   int
+	Value  *big.Rat
+	Length *big.Rat
+}
+
+// liquidation finds the most profitable way of selling complete sets back
+// into the books of a categorical market.
+//
+// Selling e sets into the bids of every book and x[j] sets into book j alone,
+// by selling the shares of its outcome into its bids and buying back the
+// shares of every other outcome from its asks, book j takes e+x[j] shares of
+// its bids and x[j] shares of its asks. The proceeds P(e) of the best x for
+// a given e are found exactly by filling the steps of every book, best first.
+// P is the value of a linear program parametrized by e, so it is concave and
+// piecewise linear, and its maximum is found by intersecting tangents.
+type liquidation struct {
+	Bids         [][]depthLevel
+	Asks         [][]depthLevel
+	CompleteSets *big.Rat
+}
+
+func newLiquidation(books []OutcomeOrderBook, market MarketData, completeSets *big.Rat) *liquidation {
+	l := &liquidation{
+		Bids:         make([][]depthLevel, len(books)),
+		Asks:         make([][]depthLevel, len(books)),
+		CompleteSets: completeSets,
+	}
+	for j, book := range books {
+		bids, asks := book.ExactLevels()
+		l.Bids[j] = getDepthLevels(bids, func(price *big.Rat) *big.Rat {
+			return new(big.Rat).Sub(price, market.MinPrice)
+		})
+		l.Asks[j] = getDepthLevels(asks, func(price *big.Rat) *big.Rat {
+			return new(big.Rat).Sub(market.MaxPrice, price)
+		})
+	}
+	return l
+}
+
+// getDepthLevels stops at the first level which yields no proceeds
+func getDepthLevels(levels []*Level, value func(price *big.Rat) *big.Rat) []depthLevel {
+	depthLevels := []depthLevel{}
+	depth := new(big.Rat)
+	for _, level := range levels {
+		if level.Amount.Sign() <= 0 {
+			continue
+		}
+		v := value(level.Price)
+		if v.Sign() <= 0 {
+			break
+		}
+		depth = new(big.Rat).Add(depth, level.Amount)
+		depthLevels = append(depthLevels, depthLevel{End: depth, Value: v})
+	}
+	return depthLevels
+}
+
+// Best returns the number of complete sets to sell into every book
+func (l *liquidation) Best() *big.Rat {
+	lo := new(big.Rat)
+	loProceeds, _, loSlope := l.slopes(lo)
+	if loSlope.Sign() <= 0 {
+		return lo
+	}
+	hi := new(big.Rat).Set(l.CompleteSets)
+	hiProceeds, hiSlope, _ := l.slopes(hi)
+	if hiSlope.Sign() >= 0 {
+		return hi
+	}
+
+	for {
+		// The tangents at lo and hi bound the proceeds from above, the
+		// bound is highest where they meet
+		m := new(big.Rat).Sub(hiProceeds, loProceeds)
+		m.Add(m, new(big.Rat).Mul(loSlope, lo))
+		m.Sub(m, new(big.Rat).Mul(hiSlope, hi))
+		m.Quo(m, new(big.Rat).Sub(loSlope, hiSlope))
+
+		proceeds, left, right := l.slopes(m)
+		bound := new(big.Rat).Sub(m, lo)
+		bound.Mul(bound, loSlope)
+		bound.Add(bound, loProceeds)
+		if proceeds.Cmp(bound) == 0 || (left.Sign() >= 0 && right.Sign() <= 0) {
+			return m
+		}
+		// Every iteration finds a new linear piece of P, so the search ends
+		if right.Sign() > 0 {
+			lo, loProceeds, loSlope = m, proceeds, right
+		} else {
+			hi, hiProceeds, hiSlope = m, proceeds, left
+		}
+	}
+}
+
+// slopes returns P(e) and the slopes of P left and right of e, zero past
+// the bounds of e
+func (l *liquidation) slopes(e *big.Rat) (*big.Rat, *big.Rat, *big.Rat) {
+	proceeds, _, gap := l.Solve(e)
+	left, right := new(big.Rat), new(big.Rat)
+
+	// Every breakpoint, the sets left to sell and the stretch of a step
+	// filled move by at most len(books)+2 as e moves by 1. Within a delta
+	// of less than half the smallest gap between them over that speed,
+	// which steps are filled does not change and P is linear.
+	delta := new(big.Rat).Quo(gap, big.NewRat(int64(2*(len(l.Bids)+2)), 1))
+	if e.Cmp(l.CompleteSets) < 0 {
+		next, _, _ := l.Solve(new(big.Rat).Add(e, delta))
+		right.Sub(next, proceeds)
+		right.Quo(right, delta)
+	}
+	if e.Sign() > 0 {
+		previous, _, _ := l.Solve(new(big.Rat).Sub(e, delta))
+		left.Sub(proceeds, previous)
+		left.Quo(left, delta)
+	}
+	return proceeds, left, right
+}
+
+// Solve returns P(e), the sets to sell into every book alone and the smallest
+// positive gap between e, the breakpoints of the bids and the steps, and the
+// sets left
+func (l *liquidation) Solve(e *big.Rat) (*big.Rat, []*big.Rat, *big.Rat) {
+	proceeds := new(big.Rat)
+	x := make([]*big.Rat, len(l.Bids))
+	gap := new(big.Rat)
+	addGap := func(d *big.Rat) {
+		if d.Sign() > 0 && (gap.Sign() == 0 || d.Cmp(gap) < 0) {
+			gap.Set(d)
+		}
+	}
+	addGap(e)
+
+	steps := []*liquidationStep{}
+	for j := range l.Bids {
+		x[j] = new(big.Rat)
+		bids, asks := l.Bids[j], l.Asks[j]
+
+		// The first e shares of the bids are sold with the sets sold into
+		// every book
+		b := 0
+		start := new(big.Rat)
+		for ; b < len(bids) && bids[b].End.Cmp(e) <= 0; b++ {
+			proceeds.Add(proceeds, new(big.Rat).Mul(bids[b].Value, new(big.Rat).Sub(bids[b].End, start)))
+			start = bids[b].End
+		}
+		if b < len(bids) {
+			proceeds.Add(proceeds, new(big.Rat).Mul(bids[b].Value, new(big.Rat).Sub(e, start)))
+		}
+		addGap(new(big.Rat).Sub(e, start))
+
+		// Merge the breakpoints of the bids left, shifted by e, and of the
+		// asks into steps of decreasing proceeds
+		position := new(big.Rat)
+		for a := 0; b < len(bids) || a < len(asks); {
+			value := new(big.Rat)
+			var end *big.Rat
+			if b < len(bids) {
+				value.Add(value, bids[b].Value)
+				end = new(big.Rat).Sub(bids[b].End, e)
+			}
+			if a < len(asks) {
+				value.Add(value, asks[a].Value)
+				if end == nil || asks[a].End.Cmp(end) < 0 {
+					end = asks[a].End
+				}
+			}
+			length := new(big.Rat).Sub(end, position)
+			steps = append(steps, &liquidationStep{Book: j, Value: value, Length: length})
+			addGap(length)
+			if b < len(bids) && new(big.Rat).Sub(bids[b].End, e).Cmp(end) == 0 {
+				b++
+			}
+			if a < len(asks) && asks[a].End.Cmp(end) == 0 {
+				a++
+			}
+			position = end
+		}
+	}
+
+	// Fill the best steps of every book with the sets left. Steps of a book
+	// are in decreasing order, so the steps of a book filled are its first.
+	sort.SliceStable(steps, func(i, k int) bool {
+		return steps[i].Value.Cmp(steps[k].Value) > 0
+	})
+	left := new(big.Rat).Sub(l.CompleteSets, e)
+	for _, step := range steps {
+		if left.Sign() == 0 {
+			break
+		}
+		filled := new(big.Rat).Set(minRat(step.Length, left))
+		if filled.Cmp(step.Length) < 0 {
+			addGap(filled)
+			addGap(new(big.Rat).Sub(step.Length, filled))
+		}
+		x[step.Book].Add(x[step.Book], filled)
+		proceeds.Add(proceeds, new(big.Rat).Mul(step.Value, filled))
+		left.Sub(left, filled)
+	}
+	addGap(left)
+	return proceeds, x, gap
+}
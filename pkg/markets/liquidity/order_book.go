@@ -1,28 +1,34 @@
 package liquidity
 
 import (
+	"math/big"
+
 	"github.com/stateshape/augur-analyzer/pkg/proto/markets"
 )
 
 type outcomeOrderBook struct {
-	Bids []*markets.LiquidityAtPrice
-	Asks []*markets.LiquidityAtPrice
+	Bids []*Level
+	Asks []*Level
 }
 
+// NewOutcomeOrderBook creates an order book from the levels of a proto order
+// book, reading every float32 as the shortest decimal which rounds to it
 func NewOutcomeOrderBook(bids []*markets.LiquidityAtPrice, asks []*markets.LiquidityAtPrice) OutcomeOrderBook {
-	bidsCopy := make([]*markets.LiquidityAtPrice, len(bids))
-	asksCopy := make([]*markets.LiquidityAtPrice, len(asks))
+	return &outcomeOrderBook{
+		Bids: LevelsFromLiquidityAtPrice(bids),
+		Asks: LevelsFromLiquidityAtPrice(asks),
+	}
+}
+
+// NewExactOutcomeOrderBook creates an order book from copies of the levels
+func NewExactOutcomeOrderBook(bids []*Level, asks []*Level) OutcomeOrderBook {
+	bidsCopy := make([]*Level, len(bids))
+	asksCopy := make([]*Level, len(asks))
 	for i, bid := range bids {
-		bidsCopy[i] = &markets.LiquidityAtPrice{
-			Price:  bid.Price,
-			Amount: bid.Amount,
-		}
+		bidsCopy[i] = bid.clone()
 	}
 	for i, ask := range asks {
-		asksCopy[i] = &markets.LiquidityAtPrice{
-			Price:  ask.Price,
-			Amount: ask.Amount,
-		}
+		asksCopy[i] = ask.clone()
 	}
 
 	return &outcomeOrderBook{
@@ -32,27 +38,19 @@ func NewOutcomeOrderBook(bids []*markets.LiquidityAtPrice, asks []*markets.Liqui
 }
 
 func (oob *outcomeOrderBook) DeepClone() OutcomeOrderBook {
-	bidsCopy := make([]*markets.LiquidityAtPrice, len(oob.Bids))
-	asksCopy := make([]*markets.LiquidityAtPrice, len(oob.Asks))
-	for i, bid := range oob.Bids {
-		bidsCopy[i] = &markets.LiquidityAtPrice{
-			Price:  bid.Price,
-			Amount: bid.Amount,
-		}
-	}
-	for i, ask := range oob.Asks {
-		asksCopy[i] = &markets.LiquidityAtPrice{
-			Price:  ask.Price,
-			Amount: ask.Amount,
-		}
-	}
-	return &outcomeOrderBook{
-		Bids: bidsCopy,
-		Asks: asksCopy,
-	}
+	return NewExactOutcomeOrderBook(oob.Bids, oob.Asks)
+}
+
+func (oob *outcomeOrderBook) Levels() ([]*markets.LiquidityAtPrice, []*markets.LiquidityAtPrice) {
+	return LiquidityAtPriceFromLevels(oob.Bids), LiquidityAtPriceFromLevels(oob.Asks)
+}
+
+func (oob *outcomeOrderBook) ExactLevels() ([]*Level, []*Level) {
+	clone := oob.DeepClone().(*outcomeOrderBook)
+	return clone.Bids, clone.Asks
 }
 
-func (oob *outcomeOrderBook) CloseLongFillOnly(shares float64, market MarketData, dryRun bool) float64 {
+func (oob *outcomeOrderBook) CloseLongFillOnly(shares *big.Rat, market MarketData, dryRun bool) *big.Rat {
 	bids, proceeds := oob.TakeBest(oob.Bids, shares, market, dryRun, false)
 	if !dryRun {
 		oob.Bids = bids
@@ -60,7 +58,7 @@ func (oob *outcomeOrderBook) CloseLongFillOnly(shares float64, market MarketData
 	return proceeds
 }
 
-func (oob *outcomeOrderBook) CloseShortFillOnly(shares float64, market MarketData, dryRun bool) float64 {
+func (oob *outcomeOrderBook) CloseShortFillOnly(shares *big.Rat, market MarketData, dryRun bool) *big.Rat {
 	asks, proceeds := oob.TakeBest(oob.Asks, shares, market, dryRun, true)
 	if !dryRun {
 		oob.Asks = asks
@@ -68,40 +66,88 @@ func (oob *outcomeOrderBook) CloseShortFillOnly(shares float64, market MarketDat
 	return proceeds
 }
 
+func (oob *outcomeOrderBook) OpenLongFillOnly(allowance *big.Rat, market MarketData, dryRun bool) (*big.Rat, *big.Rat) {
+	asks, shares, cost := oob.TakeBestCost(oob.Asks, allowance, market, dryRun, false)
+	if !dryRun {
+		oob.Asks = asks
+	}
+	return shares, cost
+}
+
+func (oob *outcomeOrderBook) OpenShortFillOnly(allowance *big.Rat, market MarketData, dryRun bool) (*big.Rat, *big.Rat) {
+	bids, shares, cost := oob.TakeBestCost(oob.Bids, allowance, market, dryRun, true)
+	if !dryRun {
+		oob.Bids = bids
+	}
+	return shares, cost
+}
+
 // NormalizeComplementPrice solves two problems: 1. when taking an Ask (closing a long), the price in order book isn't the actual proceeds for taker; the proceeds are MaxPrice-price; 2. when taking a Bid for a scalar, the price in order book isn't the actual proceeds for taker; it's price-MinPrice.
-func (oob *outcomeOrderBook) NormalizeComplementPrice(price float32, market MarketData, closingShort bool) float64 {
+func (oob *outcomeOrderBook) NormalizeComplementPrice(price *big.Rat, market MarketData, closingShort bool) *big.Rat {
 	// Complement
 	if closingShort {
-		return market.MaxPrice - float64(price)
+		return new(big.Rat).Sub(market.MaxPrice, price)
 	}
-	return float64(price) - market.MinPrice
+	return new(big.Rat).Sub(price, market.MinPrice)
 }
 
-func (oob *outcomeOrderBook) TakeBest(liquidity []*markets.LiquidityAtPrice, shares float64, market MarketData, dryRun bool, closingShort bool) ([]*markets.LiquidityAtPrice, float64) {
-	proceeds := 0.0
+func (oob *outcomeOrderBook) TakeBest(liquidity []*Level, shares *big.Rat, market MarketData, dryRun bool, closingShort bool) ([]*Level, *big.Rat) {
+	proceeds := new(big.Rat)
+	remaining := new(big.Rat).Set(shares)
 
-	for shares > 0 {
+	for remaining.Sign() > 0 {
 		if len(liquidity) < 1 {
 			return liquidity, proceeds
 		}
 
-		if float64(liquidity[0].Amount) > shares {
-			price := oob.NormalizeComplementPrice(liquidity[0].Price, market, closingShort)
-			proceeds += shares * price
+		price := oob.NormalizeComplementPrice(liquidity[0].Price, market, closingShort)
+		if liquidity[0].Amount.Cmp(remaining) > 0 {
+			proceeds.Add(proceeds, new(big.Rat).Mul(remaining, price))
 			if !dryRun {
-				liquidity[0].Amount -= float32(shares)
+				liquidity[0].Amount.Sub(liquidity[0].Amount, remaining)
 			}
-			shares -= shares
 			return liquidity, proceeds
 		}
 
-		price := oob.NormalizeComplementPrice(liquidity[0].Price, market, closingShort)
-		proceeds += float64(liquidity[0].Amount) * price
-		shares -= float64(liquidity[0].Amount)
+		proceeds.Add(proceeds, new(big.Rat).Mul(liquidity[0].Amount, price))
+		remaining.Sub(remaining, liquidity[0].Amount)
 		if !dryRun {
-			liquidity[0].Amount = 0
+			liquidity[0].Amount.SetInt64(0)
 		}
 		liquidity = liquidity[1:]
 	}
 	return liquidity, proceeds
 }
+
+// TakeBestCost is TakeBest limited by the amount spent rather than by the
+// shares taken. Opening a short by taking a bid costs MaxPrice-price, opening
+// a long by taking an ask costs price-MinPrice. It returns the shares taken
+// and their cost.
+func (oob *outcomeOrderBook) TakeBestCost(liquidity []*Level, allowance *big.Rat, market MarketData, dryRun bool, openingShort bool) ([]*Level, *big.Rat, *big.Rat) {
+	shares := new(big.Rat)
+	cost := new(big.Rat)
+	remaining := new(big.Rat).Set(allowance)
+
+	for remaining.Sign() > 0 && len(liquidity) > 0 {
+		price := oob.NormalizeComplementPrice(liquidity[0].Price, market, openingShort)
+		levelCost := new(big.Rat).Mul(liquidity[0].Amount, price)
+		if price.Sign() > 0 && levelCost.Cmp(remaining) > 0 {
+			affordable := new(big.Rat).Quo(remaining, price)
+			shares.Add(shares, affordable)
+			cost.Add(cost, remaining)
+			if !dryRun {
+				liquidity[0].Amount.Sub(liquidity[0].Amount, affordable)
+			}
+			return liquidity, shares, cost
+		}
+
+		shares.Add(shares, liquidity[0].Amount)
+		cost.Add(cost, levelCost)
+		remaining.Sub(remaining, levelCost)
+		if !dryRun {
+			liquidity[0].Amount.SetInt64(0)
+		}
+		liquidity = liquidity[1:]
+	}
+	return liquidity, shares, cost
+}
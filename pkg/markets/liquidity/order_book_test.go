@@ -1,6 +1,7 @@
 package liquidity_test
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/stateshape/augur-analyzer/pkg/markets/liquidity"
@@ -44,8 +45,8 @@ func TestTakeBids(t *testing.T) {
 			Asks:   []*markets.LiquidityAtPrice{},
 			Shares: 10.0,
 			Market: liquidity.MarketData{
-				MinPrice: 0.0,
-				MaxPrice: 1.0,
+				MinPrice: liquidity.DecimalFromFloat(0.0),
+				MaxPrice: liquidity.DecimalFromFloat(1.0),
 			},
 			ExpectedProceeds: 7.0,
 		},
@@ -76,8 +77,8 @@ func TestTakeBids(t *testing.T) {
 			Asks:   []*markets.LiquidityAtPrice{},
 			Shares: 10,
 			Market: liquidity.MarketData{
-				MinPrice: 0.0,
-				MaxPrice: 1.0,
+				MinPrice: liquidity.DecimalFromFloat(0.0),
+				MaxPrice: liquidity.DecimalFromFloat(1.0),
 			},
 			ExpectedProceeds: 8.18,
 		},
@@ -108,8 +109,8 @@ func TestTakeBids(t *testing.T) {
 			Asks:   []*markets.LiquidityAtPrice{},
 			Shares: 10,
 			Market: liquidity.MarketData{
-				MinPrice: 200,
-				MaxPrice: 300,
+				MinPrice: liquidity.DecimalFromFloat(200),
+				MaxPrice: liquidity.DecimalFromFloat(300),
 			},
 			ExpectedProceeds: 96.0,
 		},
@@ -118,7 +119,7 @@ func TestTakeBids(t *testing.T) {
 	for _, c := range cases {
 		t.Run(c.Name, func(t *testing.T) {
 			oob := liquidity.NewOutcomeOrderBook(c.Bids, c.Asks)
-			proceeds := oob.CloseLongFillOnly(c.Shares, c.Market, false)
+			proceeds, _ := oob.CloseLongFillOnly(liquidity.DecimalFromFloat(c.Shares), c.Market, false).Float64()
 			assert.Equal(t, c.ExpectedProceeds, proceeds)
 		})
 	}
@@ -161,8 +162,8 @@ func TestTakeAsks(t *testing.T) {
 			},
 			Shares: 10.0,
 			Market: liquidity.MarketData{
-				MinPrice: 0.0,
-				MaxPrice: 1.0,
+				MinPrice: liquidity.DecimalFromFloat(0.0),
+				MaxPrice: liquidity.DecimalFromFloat(1.0),
 			},
 			ExpectedProceeds: 3.0,
 		},
@@ -193,8 +194,8 @@ func TestTakeAsks(t *testing.T) {
 			},
 			Shares: 10.0,
 			Market: liquidity.MarketData{
-				MinPrice: 200,
-				MaxPrice: 300,
+				MinPrice: liquidity.DecimalFromFloat(200),
+				MaxPrice: liquidity.DecimalFromFloat(300),
 			},
 			ExpectedProceeds: 500,
 		},
@@ -203,7 +204,7 @@ func TestTakeAsks(t *testing.T) {
 	for _, c := range cases {
 		t.Run(c.Name, func(t *testing.T) {
 			oob := liquidity.NewOutcomeOrderBook(c.Bids, c.Asks)
-			proceeds := oob.CloseShortFillOnly(c.Shares, c.Market, true)
+			proceeds, _ := oob.CloseShortFillOnly(liquidity.DecimalFromFloat(c.Shares), c.Market, true).Float64()
 			assert.Equal(t, c.ExpectedProceeds, proceeds)
 		})
 	}
@@ -213,3 +214,21 @@ func TestTakeAsks(t *testing.T) {
 func TestTakeBest(t *testing.T) {
 
 }
+
+func TestTakeBidsDeepBook(t *testing.T) {
+	// A thousand levels of a thousandth of a share, which drifted
+	// away from the hand calculation with float32 amounts
+	bids := []*liquidity.Level{}
+	for i := 0; i < 1000; i++ {
+		price, _ := liquidity.ParseDecimal("0.7")
+		amount, _ := liquidity.ParseDecimal("0.001")
+		bids = append(bids, &liquidity.Level{Price: price, Amount: amount})
+	}
+	oob := liquidity.NewExactOutcomeOrderBook(bids, nil)
+	market := liquidity.MarketData{MinPrice: big.NewRat(0, 1), MaxPrice: big.NewRat(1, 1)}
+
+	proceeds := oob.CloseLongFillOnly(big.NewRat(1, 2), market, false)
+	assert.Equal(t, 0, proceeds.Cmp(big.NewRat(35, 100)))
+	proceeds = oob.CloseLongFillOnly(big.NewRat(1, 1), market, false)
+	assert.Equal(t, 0, proceeds.Cmp(big.NewRat(35, 100)))
+}
@@ -0,0 +1,75 @@
+package liquidity
+
+import (
+	"math/big"
+)
+
+// Strategy is the way an increment of complete sets is sold back into the
+// order books of a market
+type Strategy int
+
+const (
+	// Sell the shares of a single outcome into the bids of its book and buy
+	// back the shares of every other outcome from its asks
+	StrategySingleBook Strategy = iota
+	// Sell the shares of every outcome into the bids of its own book
+	StrategyEachBook
+)
+
+// RetentionTrace records every increment of a retention ratio calculation
+type RetentionTrace struct {
+	Allowance      *big.Rat
+	CompleteSets   *big.Rat
+	Increments     []*IncrementTrace
+	Proceeds       *big.Rat
+	RetentionRatio *big.Rat
+}
+
+// IncrementTrace records the sale of an increment of complete sets
+type IncrementTrace struct {
+	Shares   *big.Rat
+	Strategy Strategy
+	// Index of the book of the single book strategy
+	Book     int
+	Fills    []*Fill
+	Proceeds *big.Rat
+	// Complete sets left to sell after the increment
+	RemainingCompleteSets *big.Rat
+}
+
+// Fill records the levels of a book taken by a sale
+type Fill struct {
+	Book int
+	// The shares were bought back from the asks rather than sold into the bids
+	ClosingShort bool
+	Shares       *big.Rat
+	Proceeds     *big.Rat
+	// Levels taken, best first, with the amount taken from each
+	Levels []*Level
+}
+
+func newFill(book int, closingShort bool, proceeds *big.Rat, before, after []*Level) *Fill {
+	fill := &Fill{
+		Book:         book,
+		ClosingShort: closingShort,
+		Shares:       new(big.Rat),
+		Proceeds:     proceeds,
+		Levels:       []*Level{},
+	}
+	// The levels left are the last levels of the book before the sale
+	taken := len(before) - len(after)
+	for i, level := range before {
+		if i < taken {
+			fill.Levels = append(fill.Levels, level)
+			fill.Shares.Add(fill.Shares, level.Amount)
+			continue
+		}
+		amount := new(big.Rat).Sub(level.Amount, after[i-taken].Amount)
+		if amount.Sign() > 0 {
+			fill.Levels = append(fill.Levels, &Level{Price: level.Price, Amount: amount})
+			fill.Shares.Add(fill.Shares, amount)
+		}
+		break
+	}
+	return fill
+}
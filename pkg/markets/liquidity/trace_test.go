@@ -0,0 +1,86 @@
+package liquidity_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stateshape/augur-analyzer/pkg/currency"
+	"github.com/stateshape/augur-analyzer/pkg/markets/liquidity"
+	"github.com/stateshape/augur-analyzer/pkg/proto/markets"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalculatorTraceLiquidityRetentionRatio(t *testing.T) {
+	market := liquidity.MarketData{MinPrice: big.NewRat(0, 1), MaxPrice: big.NewRat(1, 1)}
+	calculator := liquidity.NewCalculator()
+
+	t.Run("Single book", func(t *testing.T) {
+		books := func() []liquidity.OutcomeOrderBook {
+			return []liquidity.OutcomeOrderBook{
+				liquidity.NewOutcomeOrderBook(
+					[]*markets.LiquidityAtPrice{{Price: 0.6, Amount: 0.5}, {Price: 0.5, Amount: 10}},
+					[]*markets.LiquidityAtPrice{{Price: 0.7, Amount: 1}},
+				),
+				liquidity.NewOutcomeOrderBook(
+					[]*markets.LiquidityAtPrice{{Price: 0.3, Amount: 1}},
+					[]*markets.LiquidityAtPrice{{Price: 0.5, Amount: 1}},
+				),
+			}
+		}
+		expected := calculator.GetLiquidityRetentionRatio(currency.Ether(1), market, books())
+		rr, trace := calculator.TraceLiquidityRetentionRatio(currency.Ether(1), market, books())
+		assert.Equal(t, expected.RatString(), rr.RatString())
+		assert.Equal(t, "1", trace.CompleteSets.RatString())
+
+		// Selling into the first book yields 0.9 a set for half a set and 0.8
+		// after, as much as selling into the second book or into each book
+		// after the first half. Ties go to the first book.
+		assert.Len(t, trace.Increments, 1)
+		increment := trace.Increments[0]
+		assert.Equal(t, liquidity.StrategySingleBook, increment.Strategy)
+		assert.Equal(t, 0, increment.Book)
+		assert.Equal(t, "1", increment.Shares.RatString())
+		assert.Equal(t, 0, increment.RemainingCompleteSets.Sign())
+		assert.Len(t, increment.Fills, 2)
+		assert.False(t, increment.Fills[0].ClosingShort)
+		assert.Equal(t, "11/20", increment.Fills[0].Proceeds.RatString())
+		assert.Len(t, increment.Fills[0].Levels, 2)
+		assert.Equal(t, "1/2", increment.Fills[0].Levels[1].Amount.RatString())
+		assert.True(t, increment.Fills[1].ClosingShort)
+		assert.Equal(t, "3/10", increment.Fills[1].Proceeds.RatString())
+		assert.Equal(t, "17/20", increment.Proceeds.RatString())
+		assert.Equal(t, "17/20", trace.Proceeds.RatString())
+	})
+
+	t.Run("Each book", func(t *testing.T) {
+		books := []liquidity.OutcomeOrderBook{
+			liquidity.NewOutcomeOrderBook([]*markets.LiquidityAtPrice{{Price: 0.6, Amount: 1}}, nil),
+			liquidity.NewOutcomeOrderBook([]*markets.LiquidityAtPrice{{Price: 0.3, Amount: 0.2}}, nil),
+		}
+		rr, trace := calculator.TraceLiquidityRetentionRatio(currency.Ether(0.5), market, books)
+		assert.Len(t, trace.Increments, 1)
+		increment := trace.Increments[0]
+		assert.Equal(t, liquidity.StrategyEachBook, increment.Strategy)
+		assert.Len(t, increment.Fills, 2)
+		assert.Equal(t, 1, increment.Fills[1].Book)
+		assert.Equal(t, "1/5", increment.Fills[1].Shares.RatString())
+		// 0.5 shares at 0.6 and 0.2 shares at 0.3
+		assert.Equal(t, "9/25", increment.Proceeds.RatString())
+		assert.Equal(t, "18/25", rr.RatString())
+	})
+
+	t.Run("Yes/no", func(t *testing.T) {
+		books := []liquidity.OutcomeOrderBook{
+			liquidity.NewOutcomeOrderBook(
+				[]*markets.LiquidityAtPrice{{Price: 0.4, Amount: 1}},
+				[]*markets.LiquidityAtPrice{{Price: 0.5, Amount: 1}},
+			),
+		}
+		rr, trace := calculator.TraceLiquidityRetentionRatio(currency.Ether(1), market, books)
+		assert.Equal(t, "9/10", rr.RatString())
+		assert.Len(t, trace.Increments, 1)
+		assert.Equal(t, "1", trace.Increments[0].Shares.RatString())
+		assert.Len(t, trace.Increments[0].Fills, 2)
+	})
+}
@@ -0,0 +1,205 @@
+package markets
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/stateshape/augur-analyzer/pkg/proto/augur"
+	"github.com/stateshape/augur-analyzer/pkg/proto/markets"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Number of market makers published in the leaderboard object, all market
+// makers can be looked up by address
+const MaxMarketMakerLeaderboardLength = 100
+
+type makerQuotes struct {
+	BestBid float64
+	BestAsk float64
+	HasBid  bool
+	HasAsk  bool
+}
+
+type makerAccumulator struct {
+	Depth      float64
+	OpenOrders uint64
+	MarketIDs  map[string]struct{}
+	// Spreads as a fraction of the price range, by market and outcome
+	Spreads []float64
+}
+
+// MarketMakerTracker aggregates the open orders of every block by owner and
+// tracks how long each owner had open orders since the tracker started
+type MarketMakerTracker struct {
+	mu              sync.RWMutex
+	start           time.Time
+	lastUpdate      time.Time
+	presenceByMaker map[string]time.Duration
+	makersByAddress map[string]*markets.MarketMaker
+}
+
+func NewMarketMakerTracker() *MarketMakerTracker {
+	return &MarketMakerTracker{
+		presenceByMaker: map[string]time.Duration{},
+		makersByAddress: map[string]*markets.MarketMaker{},
+	}
+}
+
+// Update aggregates the open orders of a block and returns the leaderboard
+func (t *MarketMakerTracker) Update(block uint64, now time.Time, msd *MarketsData) *markets.MarketMakerLeaderboard {
+	accumulators := map[string]*makerAccumulator{}
+	for marketID, md := range msd.ByMarketID {
+		if err := accumulateMarketMakers(accumulators, marketID, md); err != nil {
+			logrus.WithField("marketAddress", marketID).WithError(err).Errorf("Failed to aggregate the open orders of a market by owner")
+		}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.start.IsZero() {
+		t.start, t.lastUpdate = now, now
+	}
+	elapsed := now.Sub(t.lastUpdate)
+	t.lastUpdate = now
+	for address := range accumulators {
+		t.presenceByMaker[address] += elapsed
+	}
+	observed := now.Sub(t.start)
+
+	ethusd, btceth := 0.0, 0.0
+	if msd.ExchangeRates != nil {
+		ethusd, btceth = msd.ExchangeRates.ETHUSD, msd.ExchangeRates.BTCETH
+	}
+
+	makers := []*markets.MarketMaker{}
+	t.makersByAddress = map[string]*markets.MarketMaker{}
+	for address, acc := range accumulators {
+		maker := &markets.MarketMaker{
+			Address:       address,
+			TotalDepth:    ethToPrice(acc.Depth, ethusd, btceth),
+			MarketsQuoted: uint64(len(acc.MarketIDs)),
+			OpenOrders:    acc.OpenOrders,
+			Presence:      1,
+			MarketIds:     []string{},
+		}
+		for marketID := range acc.MarketIDs {
+			maker.MarketIds = append(maker.MarketIds, marketID)
+		}
+		sort.Strings(maker.MarketIds)
+		if len(acc.Spreads) > 0 {
+			total := 0.0
+			for _, spread := range acc.Spreads {
+				total += spread
+			}
+			maker.AverageSpread = float32(total / float64(len(acc.Spreads)))
+		}
+		if observed > 0 {
+			maker.Presence = float32(float64(t.presenceByMaker[address]) / float64(observed))
+		}
+		makers = append(makers, maker)
+		t.makersByAddress[address] = maker
+	}
+	sort.Slice(makers, func(i, j int) bool {
+		if makers[i].TotalDepth.Eth != makers[j].TotalDepth.Eth {
+			return makers[i].TotalDepth.Eth > makers[j].TotalDepth.Eth
+		}
+		return makers[i].Address < makers[j].Address
+	})
+	if len(makers) > MaxMarketMakerLeaderboardLength {
+		makers = makers[:MaxMarketMakerLeaderboardLength]
+	}
+
+	return &markets.MarketMakerLeaderboard{
+		Block:          block,
+		GenerationTime: uint64(now.Unix()),
+		MarketMakers:   makers,
+	}
+}
+
+// Lookup returns the market maker with the address as of the last update
+func (t *MarketMakerTracker) Lookup(address string) (*markets.MarketMaker, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	maker, ok := t.makersByAddress[strings.ToLower(address)]
+	return maker, ok
+}
+
+func accumulateMarketMakers(accumulators map[string]*makerAccumulator, marketID string, md *MarketData) error {
+	if md.Info == nil || md.Orders == nil {
+		return nil
+	}
+	minPrice, err := strconv.ParseFloat(md.Info.MinPrice, 64)
+	if err != nil {
+		return err
+	}
+	maxPrice, err := strconv.ParseFloat(md.Info.MaxPrice, 64)
+	if err != nil {
+		return err
+	}
+
+	for _, ordersByOrderType := range md.Orders.OrdersByOrderIdByOrderTypeByOutcome {
+		quotesByMaker := map[string]*makerQuotes{}
+		for _, side := range []struct {
+			orders *augur.GetOrdersResponse_OrdersByOrderId
+			isBid  bool
+		}{
+			{ordersByOrderType.BuyOrdersByOrderId, true},
+			{ordersByOrderType.SellOrdersByOrderId, false},
+		} {
+			if side.orders == nil {
+				continue
+			}
+			for _, order := range side.orders.OrdersByOrderId {
+				if order.OrderState != augur.OrderState_OPEN {
+					continue
+				}
+				price, err := strconv.ParseFloat(order.Price, 64)
+				if err != nil {
+					return err
+				}
+				amount, err := strconv.ParseFloat(order.Amount, 64)
+				if err != nil {
+					return err
+				}
+
+				address := strings.ToLower(order.Owner)
+				acc, ok := accumulators[address]
+				if !ok {
+					acc = &makerAccumulator{MarketIDs: map[string]struct{}{}}
+					accumulators[address] = acc
+				}
+				acc.OpenOrders++
+				acc.MarketIDs[marketID] = struct{}{}
+
+				quotes, ok := quotesByMaker[address]
+				if !ok {
+					quotes = &makerQuotes{BestAsk: math.Inf(1), BestBid: math.Inf(-1)}
+					quotesByMaker[address] = quotes
+				}
+				if side.isBid {
+					acc.Depth += amount * (price - minPrice)
+					quotes.HasBid = true
+					quotes.BestBid = math.Max(quotes.BestBid, price)
+					continue
+				}
+				acc.Depth += amount * (maxPrice - price)
+				quotes.HasAsk = true
+				quotes.BestAsk = math.Min(quotes.BestAsk, price)
+			}
+		}
+
+		for address, quotes := range quotesByMaker {
+			if quotes.HasBid && quotes.HasAsk && maxPrice > minPrice {
+				acc := accumulators[address]
+				acc.Spreads = append(acc.Spreads, (quotes.BestAsk-quotes.BestBid)/(maxPrice-minPrice))
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package markets_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stateshape/augur-analyzer/pkg/markets"
+	"github.com/stateshape/augur-analyzer/pkg/proto/augur"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarketMakerTrackerUpdate(t *testing.T) {
+	order := func(owner, price, amount string) *augur.Order {
+		return &augur.Order{Owner: owner, OrderState: augur.OrderState_OPEN, Price: price, Amount: amount}
+	}
+	data := func(withBob bool) *markets.MarketsData {
+		bids := map[string]*augur.Order{"1": order("0xAlice", "0.4", "10")}
+		asks := map[string]*augur.Order{"2": order("0xalice", "0.5", "10")}
+		if withBob {
+			asks["3"] = order("0xbob", "0.9", "1")
+		}
+		return &markets.MarketsData{
+			ByMarketID: map[string]*markets.MarketData{
+				"a": {
+					Info: &augur.MarketInfo{Id: "a", MinPrice: "0", MaxPrice: "1"},
+					Orders: &augur.GetOrdersResponse_OrdersByOrderIdByOrderTypeByOutcome{
+						OrdersByOrderIdByOrderTypeByOutcome: map[uint64]*augur.GetOrdersResponse_OrdersByOrderIdByOrderType{
+							1: {
+								BuyOrdersByOrderId:  &augur.GetOrdersResponse_OrdersByOrderId{OrdersByOrderId: bids},
+								SellOrdersByOrderId: &augur.GetOrdersResponse_OrdersByOrderId{OrdersByOrderId: asks},
+							},
+						},
+					},
+				},
+				"b": {
+					Info: &augur.MarketInfo{Id: "b", MinPrice: "0", MaxPrice: "1"},
+					Orders: &augur.GetOrdersResponse_OrdersByOrderIdByOrderTypeByOutcome{
+						OrdersByOrderIdByOrderTypeByOutcome: map[uint64]*augur.GetOrdersResponse_OrdersByOrderIdByOrderType{
+							2: {
+								BuyOrdersByOrderId: &augur.GetOrdersResponse_OrdersByOrderId{
+									OrdersByOrderId: map[string]*augur.Order{"4": order("0xalice", "0.2", "5")},
+								},
+							},
+						},
+					},
+				},
+			},
+			ExchangeRates: &markets.ExchangeRates{ETHUSD: 100, BTCETH: 10},
+		}
+	}
+
+	tracker := markets.NewMarketMakerTracker()
+	tracker.Update(1, time.Unix(0, 0), data(true))
+	leaderboard := tracker.Update(2, time.Unix(60, 0), data(false))
+	leaderboard = tracker.Update(3, time.Unix(120, 0), data(true))
+
+	assert.Equal(t, uint64(3), leaderboard.Block)
+	assert.Equal(t, 2, len(leaderboard.MarketMakers))
+
+	alice := leaderboard.MarketMakers[0]
+	assert.Equal(t, "0xalice", alice.Address)
+	assert.InDelta(t, 10*0.4+10*0.5+5*0.2, alice.TotalDepth.Eth, 1e-5)
+	assert.InDelta(t, 1000, alice.TotalDepth.Usd, 1e-3)
+	assert.Equal(t, uint64(2), alice.MarketsQuoted)
+	assert.Equal(t, uint64(3), alice.OpenOrders)
+	assert.InDelta(t, 0.1, alice.AverageSpread, 1e-6)
+	assert.Equal(t, float32(1), alice.Presence)
+	assert.Equal(t, []string{"a", "b"}, alice.MarketIds)
+
+	bob := leaderboard.MarketMakers[1]
+	assert.InDelta(t, 0.1, bob.TotalDepth.Eth, 1e-6)
+	assert.Equal(t, float32(0), bob.AverageSpread)
+	assert.Equal(t, float32(0.5), bob.Presence)
+
+	maker, ok := tracker.Lookup("0xBOB")
+	assert.True(t, ok)
+	assert.Equal(t, "0xbob", maker.Address)
+	_, ok = tracker.Lookup("0xcarol")
+	assert.False(t, ok)
+}
@@ -0,0 +1,148 @@
+package markets
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/stateshape/augur-analyzer/pkg/proto/augur"
+	"github.com/stateshape/augur-analyzer/pkg/proto/markets"
+
+	"github.com/sirupsen/logrus"
+)
+
+type openOrder struct {
+	Owner          string
+	CreationTime   uint64
+	Amount         float64
+	OriginalAmount float64
+	TokensEscrowed float64
+	SharesEscrowed float64
+}
+
+// GetOrderBookAnalytics computes statistics of the open orders of a market,
+// for the whole market and for every outcome with orders
+func GetOrderBookAnalytics(orders *augur.GetOrdersResponse_OrdersByOrderIdByOrderTypeByOutcome, now time.Time) (*markets.OrderBookAnalytics, error) {
+	analytics := &markets.OrderBookAnalytics{
+		Market:    getOrderStatistics([]*openOrder{}, now),
+		ByOutcome: map[uint64]*markets.OrderStatistics{},
+	}
+	if orders == nil || orders.OrdersByOrderIdByOrderTypeByOutcome == nil {
+		return analytics, nil
+	}
+
+	all := []*openOrder{}
+	for outcome, ordersByOrderType := range orders.OrdersByOrderIdByOrderTypeByOutcome {
+		open := []*openOrder{}
+		for _, ordersByOrderID := range []*augur.GetOrdersResponse_OrdersByOrderId{
+			ordersByOrderType.BuyOrdersByOrderId,
+			ordersByOrderType.SellOrdersByOrderId,
+		} {
+			if ordersByOrderID == nil {
+				continue
+			}
+			for _, order := range ordersByOrderID.OrdersByOrderId {
+				if order.OrderState != augur.OrderState_OPEN {
+					continue
+				}
+				o, err := parseOpenOrder(order)
+				if err != nil {
+					logrus.WithFields(logrus.Fields{
+						"orderId":              order.OrderId,
+						"orderTransactionHash": order.TransactionHash,
+					}).WithError(err).Errorf("Failed to parse order amounts from strings")
+					return nil, err
+				}
+				open = append(open, o)
+			}
+		}
+		if len(open) == 0 {
+			continue
+		}
+		analytics.ByOutcome[outcome] = getOrderStatistics(open, now)
+		all = append(all, open...)
+	}
+	analytics.Market = getOrderStatistics(all, now)
+	return analytics, nil
+}
+
+func parseOpenOrder(order *augur.Order) (*openOrder, error) {
+	o := &openOrder{
+		Owner:        order.Owner,
+		CreationTime: order.CreationTime,
+	}
+	for _, field := range []struct {
+		value string
+		dest  *float64
+	}{
+		{order.Amount, &o.Amount},
+		{order.OriginalAmount, &o.OriginalAmount},
+		{order.TokensEscrowed, &o.TokensEscrowed},
+		{order.SharesEscrowed, &o.SharesEscrowed},
+	} {
+		// Escrow fields are omitted when nothing is escrowed
+		if field.value == "" {
+			continue
+		}
+		v, err := strconv.ParseFloat(field.value, 64)
+		if err != nil {
+			return nil, err
+		}
+		*field.dest = v
+	}
+	return o, nil
+}
+
+func getOrderStatistics(orders []*openOrder, now time.Time) *markets.OrderStatistics {
+	stats := &markets.OrderStatistics{
+		OpenOrders: uint64(len(orders)),
+	}
+	if len(orders) == 0 {
+		return stats
+	}
+
+	amountByOwner := map[string]float64{}
+	total, original, escrowedTokens, escrowedShares := 0.0, 0.0, 0.0, 0.0
+	ages := []uint64{}
+	for _, o := range orders {
+		amountByOwner[o.Owner] += o.Amount
+		total += o.Amount
+		original += o.OriginalAmount
+		escrowedTokens += o.TokensEscrowed
+		escrowedShares += o.SharesEscrowed
+
+		age := uint64(0)
+		if created := o.CreationTime; created < uint64(now.Unix()) {
+			age = uint64(now.Unix()) - created
+		}
+		ages = append(ages, age)
+	}
+
+	stats.DistinctMakers = uint64(len(amountByOwner))
+	if total > 0 {
+		top, hhi := 0.0, 0.0
+		for _, amount := range amountByOwner {
+			share := amount / total
+			if share > top {
+				top = share
+			}
+			hhi += share * share
+		}
+		stats.TopMakerShare = float32(top)
+		stats.MakerConcentrationIndex = float32(hhi)
+	}
+
+	sort.Slice(ages, func(i, j int) bool { return ages[i] < ages[j] })
+	if len(ages)%2 == 1 {
+		stats.MedianOrderAge = ages[len(ages)/2]
+	} else {
+		stats.MedianOrderAge = (ages[len(ages)/2-1] + ages[len(ages)/2]) / 2
+	}
+
+	if original > 0 {
+		stats.FilledFraction = float32((original - total) / original)
+	}
+	stats.TokensEscrowed = float32(escrowedTokens)
+	stats.SharesEscrowed = float32(escrowedShares)
+	return stats
+}
@@ -0,0 +1,67 @@
+package markets_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stateshape/augur-analyzer/pkg/markets"
+	"github.com/stateshape/augur-analyzer/pkg/proto/augur"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetOrderBookAnalytics(t *testing.T) {
+	orders := &augur.GetOrdersResponse_OrdersByOrderIdByOrderTypeByOutcome{
+		OrdersByOrderIdByOrderTypeByOutcome: map[uint64]*augur.GetOrdersResponse_OrdersByOrderIdByOrderType{
+			1: {
+				BuyOrdersByOrderId: &augur.GetOrdersResponse_OrdersByOrderId{
+					OrdersByOrderId: map[string]*augur.Order{
+						"1": {Owner: "alice", OrderState: augur.OrderState_OPEN, CreationTime: 900, Amount: "6", OriginalAmount: "10", TokensEscrowed: "3"},
+						"2": {Owner: "bob", OrderState: augur.OrderState_OPEN, CreationTime: 700, Amount: "2", OriginalAmount: "2", SharesEscrowed: "2"},
+						"3": {Owner: "carol", OrderState: augur.OrderState_CANCELED, CreationTime: 100, Amount: "100", OriginalAmount: "100"},
+					},
+				},
+			},
+			2: {
+				SellOrdersByOrderId: &augur.GetOrdersResponse_OrdersByOrderId{
+					OrdersByOrderId: map[string]*augur.Order{
+						"4": {Owner: "alice", OrderState: augur.OrderState_OPEN, CreationTime: 500, Amount: "2", OriginalAmount: "4", TokensEscrowed: "1"},
+					},
+				},
+			},
+			3: {},
+		},
+	}
+
+	analytics, err := markets.GetOrderBookAnalytics(orders, time.Unix(1000, 0))
+	assert.Nil(t, err)
+
+	market := analytics.Market
+	assert.Equal(t, uint64(3), market.OpenOrders)
+	assert.Equal(t, uint64(2), market.DistinctMakers)
+	assert.InDelta(t, 0.8, market.TopMakerShare, 1e-6)
+	assert.InDelta(t, 0.8*0.8+0.2*0.2, market.MakerConcentrationIndex, 1e-6)
+	assert.Equal(t, uint64(300), market.MedianOrderAge)
+	assert.InDelta(t, 6.0/16.0, market.FilledFraction, 1e-6)
+	assert.Equal(t, float32(4), market.TokensEscrowed)
+	assert.Equal(t, float32(2), market.SharesEscrowed)
+
+	assert.Equal(t, 2, len(analytics.ByOutcome))
+	assert.Equal(t, uint64(2), analytics.ByOutcome[1].OpenOrders)
+	assert.Equal(t, uint64(200), analytics.ByOutcome[1].MedianOrderAge)
+	assert.Equal(t, float32(1), analytics.ByOutcome[2].TopMakerShare)
+	assert.Equal(t, float32(1), analytics.ByOutcome[2].MakerConcentrationIndex)
+
+	_, err = markets.GetOrderBookAnalytics(&augur.GetOrdersResponse_OrdersByOrderIdByOrderTypeByOutcome{
+		OrdersByOrderIdByOrderTypeByOutcome: map[uint64]*augur.GetOrdersResponse_OrdersByOrderIdByOrderType{
+			1: {
+				BuyOrdersByOrderId: &augur.GetOrdersResponse_OrdersByOrderId{
+					OrdersByOrderId: map[string]*augur.Order{
+						"1": {OrderState: augur.OrderState_OPEN, Amount: "a lot"},
+					},
+				},
+			},
+		},
+	}, time.Unix(1000, 0))
+	assert.NotNil(t, err)
+}
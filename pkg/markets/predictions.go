@@ -1,10 +1,12 @@
 package markets
 
 import (
+	"math/big"
 	"sort"
 	"strconv"
 
 	"github.com/sirupsen/logrus"
+	"github.com/stateshape/augur-analyzer/pkg/markets/liquidity"
 	"github.com/stateshape/augur-analyzer/pkg/proto/augur"
 	"github.com/stateshape/augur-analyzer/pkg/proto/markets"
 )
@@ -65,92 +67,64 @@ func convertToOutcomes(ois []*augur.OutcomeInfo) ([]*Outcome, error) {
 	return outcomes, nil
 }
 
+// GetBids aggregates the open buy orders of every outcome by price, highest
+// price first
 func GetBids(orders *augur.GetOrdersResponse_OrdersByOrderIdByOrderTypeByOutcome) (map[uint64]*markets.ListLiquidityAtPrice, error) {
-	bestBidsByOutcome := map[uint64]*markets.ListLiquidityAtPrice{}
-
-	if orders == nil || orders.OrdersByOrderIdByOrderTypeByOutcome == nil {
-		return bestBidsByOutcome, nil
+	levels, err := GetBidLevels(orders)
+	if err != nil {
+		return map[uint64]*markets.ListLiquidityAtPrice{}, err
 	}
+	return getListsLiquidityAtPrice(levels), nil
+}
 
-	for outcome, ordersByOrderType := range orders.OrdersByOrderIdByOrderTypeByOutcome {
-		bestBidsByOutcome[outcome] = &markets.ListLiquidityAtPrice{
-			LiquidityAtPrice: []*markets.LiquidityAtPrice{},
-		}
-
-		if ordersByOrderType.BuyOrdersByOrderId == nil || len(ordersByOrderType.BuyOrdersByOrderId.OrdersByOrderId) == 0 {
-			continue
-		}
+// GetAsks aggregates the open sell orders of every outcome by price, lowest
+// price first
+func GetAsks(orders *augur.GetOrdersResponse_OrdersByOrderIdByOrderTypeByOutcome) (map[uint64]*markets.ListLiquidityAtPrice, error) {
+	levels, err := GetAskLevels(orders)
+	if err != nil {
+		return map[uint64]*markets.ListLiquidityAtPrice{}, err
+	}
+	return getListsLiquidityAtPrice(levels), nil
+}
 
-		bidPriceIndexes := map[float32]int{}
-		for _, order := range ordersByOrderType.BuyOrdersByOrderId.OrdersByOrderId {
-			if order.OrderState != augur.OrderState_OPEN {
-				continue
-			}
-			price, err := strconv.ParseFloat(order.Price, 32)
-			if err != nil {
-				logrus.WithFields(logrus.Fields{
-					"orderPrice":           order.Price,
-					"orderAmount":          order.Amount,
-					"orderId":              order.OrderId,
-					"orderTransactionHash": order.TransactionHash,
-				}).Errorf("Failed to parse order price from string")
-				return map[uint64]*markets.ListLiquidityAtPrice{}, err
-			}
-			amount, err := strconv.ParseFloat(order.Amount, 32)
-			if err != nil {
-				logrus.WithFields(logrus.Fields{
-					"orderPrice":           order.Price,
-					"orderAmount":          order.Amount,
-					"orderId":              order.OrderId,
-					"orderTransactionHash": order.TransactionHash,
-				}).Errorf("Failed to parse order amount from string")
-				return map[uint64]*markets.ListLiquidityAtPrice{}, err
-			}
+// GetBidLevels is GetBids with the exact full precision prices and amounts
+// of the orders
+func GetBidLevels(orders *augur.GetOrdersResponse_OrdersByOrderIdByOrderTypeByOutcome) (map[uint64][]*liquidity.Level, error) {
+	return getLevels(orders, true)
+}
 
-			// Accumulate all liquidity at the highest bid price
-			if index, ok := bidPriceIndexes[float32(price)]; ok {
-				bestBidsByOutcome[outcome].LiquidityAtPrice[index].Amount += float32(amount)
-			} else {
-				bestBidsByOutcome[outcome].LiquidityAtPrice = append(bestBidsByOutcome[outcome].LiquidityAtPrice, &markets.LiquidityAtPrice{
-					Price:  float32(price),
-					Amount: float32(amount),
-				})
-				bidPriceIndexes[float32(price)] = len(bestBidsByOutcome[outcome].LiquidityAtPrice) - 1
-			}
-		}
-	}
-	for _, listLiquidityAtPrice := range bestBidsByOutcome {
-		sort.Slice(listLiquidityAtPrice.LiquidityAtPrice, func(i, j int) bool {
-			return listLiquidityAtPrice.LiquidityAtPrice[i].Price > listLiquidityAtPrice.LiquidityAtPrice[j].Price
-		})
-	}
-	return bestBidsByOutcome, nil
+// GetAskLevels is GetAsks with the exact full precision prices and amounts
+// of the orders
+func GetAskLevels(orders *augur.GetOrdersResponse_OrdersByOrderIdByOrderTypeByOutcome) (map[uint64][]*liquidity.Level, error) {
+	return getLevels(orders, false)
 }
 
-func GetAsks(orders *augur.GetOrdersResponse_OrdersByOrderIdByOrderTypeByOutcome) (map[uint64]*markets.ListLiquidityAtPrice, error) {
-	bestAsksByOutcome := map[uint64]*markets.ListLiquidityAtPrice{}
+func getLevels(orders *augur.GetOrdersResponse_OrdersByOrderIdByOrderTypeByOutcome, buy bool) (map[uint64][]*liquidity.Level, error) {
+	levelsByOutcome := map[uint64][]*liquidity.Level{}
 
 	if orders == nil || orders.OrdersByOrderIdByOrderTypeByOutcome == nil {
-		return bestAsksByOutcome, nil
+		return levelsByOutcome, nil
 	}
 
 	for outcome, ordersByOrderType := range orders.OrdersByOrderIdByOrderTypeByOutcome {
-		// Instantiate the map for the outcome
-		bestAsksByOutcome[outcome] = &markets.ListLiquidityAtPrice{
-			LiquidityAtPrice: []*markets.LiquidityAtPrice{},
-		}
+		// Instantiate the levels for the outcome
+		levelsByOutcome[outcome] = []*liquidity.Level{}
 
-		// If no sell orders for outcome skip
-		if ordersByOrderType.SellOrdersByOrderId == nil || len(ordersByOrderType.SellOrdersByOrderId.OrdersByOrderId) == 0 {
+		side := ordersByOrderType.SellOrdersByOrderId
+		if buy {
+			side = ordersByOrderType.BuyOrdersByOrderId
+		}
+		// If no orders on the side for outcome skip
+		if side == nil || len(side.OrdersByOrderId) == 0 {
 			continue
 		}
 
-		askPriceIndexes := map[float32]int{}
-		for _, order := range ordersByOrderType.SellOrdersByOrderId.OrdersByOrderId {
+		priceIndexes := map[string]int{}
+		for _, order := range side.OrdersByOrderId {
 			if order.OrderState != augur.OrderState_OPEN {
 				continue
 			}
-			price, err := strconv.ParseFloat(order.Price, 32)
+			price, err := parseOrderDecimal(order.FullPrecisionPrice, order.Price)
 			if err != nil {
 				logrus.WithFields(logrus.Fields{
 					"orderPrice":           order.Price,
@@ -158,9 +132,9 @@ func GetAsks(orders *augur.GetOrdersResponse_OrdersByOrderIdByOrderTypeByOutcome
 					"orderId":              order.OrderId,
 					"orderTransactionHash": order.TransactionHash,
 				}).Errorf("Failed to parse order price from string")
-				return map[uint64]*markets.ListLiquidityAtPrice{}, err
+				return map[uint64][]*liquidity.Level{}, err
 			}
-			amount, err := strconv.ParseFloat(order.Amount, 32)
+			amount, err := parseOrderDecimal(order.FullPrecisionAmount, order.Amount)
 			if err != nil {
 				logrus.WithFields(logrus.Fields{
 					"orderPrice":           order.Price,
@@ -168,27 +142,52 @@ func GetAsks(orders *augur.GetOrdersResponse_OrdersByOrderIdByOrderTypeByOutcome
 					"orderId":              order.OrderId,
 					"orderTransactionHash": order.TransactionHash,
 				}).Errorf("Failed to parse order amount from string")
-				return map[uint64]*markets.ListLiquidityAtPrice{}, err
+				return map[uint64][]*liquidity.Level{}, err
 			}
 
-			// Accumulate all liquidity at the each price point
-			if index, ok := askPriceIndexes[float32(price)]; ok {
-				bestAsksByOutcome[outcome].LiquidityAtPrice[index].Amount += float32(amount)
+			// Accumulate all liquidity at each price point
+			key := price.RatString()
+			if index, ok := priceIndexes[key]; ok {
+				levelsByOutcome[outcome][index].Amount.Add(levelsByOutcome[outcome][index].Amount, amount)
+				levelsByOutcome[outcome][index].Orders++
 			} else {
-				bestAsksByOutcome[outcome].LiquidityAtPrice = append(bestAsksByOutcome[outcome].LiquidityAtPrice, &markets.LiquidityAtPrice{
-					Price:  float32(price),
-					Amount: float32(amount),
+				levelsByOutcome[outcome] = append(levelsByOutcome[outcome], &liquidity.Level{
+					Price:  price,
+					Amount: amount,
+					Orders: 1,
 				})
-				askPriceIndexes[float32(price)] = len(bestAsksByOutcome[outcome].LiquidityAtPrice) - 1
+				priceIndexes[key] = len(levelsByOutcome[outcome]) - 1
 			}
 		}
 	}
-	for _, listLiquidityAtPrices := range bestAsksByOutcome {
-		sort.Slice(listLiquidityAtPrices.LiquidityAtPrice, func(i, j int) bool {
-			return listLiquidityAtPrices.LiquidityAtPrice[i].Price < listLiquidityAtPrices.LiquidityAtPrice[j].Price
+	for _, levels := range levelsByOutcome {
+		sort.Slice(levels, func(i, j int) bool {
+			if buy {
+				return levels[i].Price.Cmp(levels[j].Price) > 0
+			}
+			return levels[i].Price.Cmp(levels[j].Price) < 0
 		})
 	}
-	return bestAsksByOutcome, nil
+	return levelsByOutcome, nil
+}
+
+// parseOrderDecimal parses the full precision price or amount of an order,
+// falling back to the rounded one for orders without full precision
+func parseOrderDecimal(fullPrecision, rounded string) (*big.Rat, error) {
+	if fullPrecision != "" {
+		return liquidity.ParseDecimal(fullPrecision)
+	}
+	return liquidity.ParseDecimal(rounded)
+}
+
+func getListsLiquidityAtPrice(levelsByOutcome map[uint64][]*liquidity.Level) map[uint64]*markets.ListLiquidityAtPrice {
+	lists := map[uint64]*markets.ListLiquidityAtPrice{}
+	for outcome, levels := range levelsByOutcome {
+		lists[outcome] = &markets.ListLiquidityAtPrice{
+			LiquidityAtPrice: liquidity.LiquidityAtPriceFromLevels(levels),
+		}
+	}
+	return lists
 }
 
 func getYesNoPredictions(m *Market, outcomes []*Outcome, bestBids, bestAsks map[uint64]*markets.LiquidityAtPrice) []*markets.Prediction {
@@ -217,6 +216,7 @@ func getYesNoPredictions(m *Market, outcomes []*Outcome, bestBids, bestAsks map[
 				Name:      "yes",
 				Percent:   float32(percent * 100),
 				OutcomeId: 1,
+				Method:    markets.PredictionMethod_LAST_TRADE_PRICE,
 			},
 		}
 	}
@@ -228,32 +228,40 @@ func getYesNoPredictions(m *Market, outcomes []*Outcome, bestBids, bestAsks map[
 	bestYesAsk, yesAskExists := bestAsks[1]
 
 	var price float32 = 0.0
+	method := markets.PredictionMethod_NO_PRICE
 	if yesBidExists && yesAskExists {
 		// If both YES bids and YES asks, use the weighted avg of the
 		// best YES bid and the best YES ask
 		price = ((bestYesBid.Price * bestYesBid.Amount) + (bestYesAsk.Price * bestYesAsk.Amount)) / (bestYesBid.Amount + bestYesAsk.Amount)
+		method = markets.PredictionMethod_ORDER_BOOK_MIDPOINT
 	} else if yesBidExists && !yesAskExists {
 		// If YES bids and no YES asks, use the best YES bid
 		price = bestYesBid.Price
+		method = markets.PredictionMethod_BEST_BID
 	} else if !yesBidExists && yesAskExists {
 		// If no YES bids and YES asks, use the best YES ask
 		price = bestYesAsk.Price
+		method = markets.PredictionMethod_BEST_ASK
 	} else if noBidExists && noAskExists {
 		// If NO bids and NO asks, use 1 - the weighted avg of the
 		// best NO bid and the best NO ask
 		price = 1 - ((bestNoBid.Price*bestNoBid.Amount)+(bestNoAsk.Price*bestNoAsk.Amount))/(bestNoBid.Amount+bestNoAsk.Amount)
+		method = markets.PredictionMethod_ORDER_BOOK_MIDPOINT
 	} else if noBidExists && !noAskExists {
 		// If NO bids and no NO asks, use 1 - best NO bid
 		price = 1 - bestNoBid.Price
+		method = markets.PredictionMethod_BEST_BID
 	} else if !noBidExists && noAskExists {
 		// IF no NO bids and NO asks, use 1 - best NO ask
 		price = 1 - bestNoAsk.Price
+		method = markets.PredictionMethod_BEST_ASK
 	}
 	return []*markets.Prediction{
 		{
 			Name:      "yes",
 			Percent:   (price * 100),
 			OutcomeId: 1,
+			Method:    method,
 		},
 	}
 }
@@ -264,69 +272,51 @@ func getCategoricalPredictions(m *Market, outcomes []*Outcome, bestBids, bestAsk
 		return []*markets.Prediction{}
 	}
 
-	if m.Volume > 0 {
-		// Sort the outcomes so that the prediction are sent to client
-		// in order of most probable to least probable
-		sort.Slice(outcomes, func(i, j int) bool {
-			return outcomes[i].Price > outcomes[j].Price
-		})
-		predictions := []*markets.Prediction{}
-		for _, o := range outcomes {
-			predictions = append(predictions, &markets.Prediction{
-				Name:      o.Description,
-				Percent:   float32(o.Price * 100),
-				OutcomeId: o.ID,
-			})
-		}
-		return predictions
-	}
-
-	// Derive prediction using liquidity
-	liquidityPricedOutcomes := []*Outcome{}
-	for _, o := range outcomes {
-		liquidityPricedOutcomes = append(liquidityPricedOutcomes, &Outcome{
-			ID:          o.ID,
-			Description: o.Description,
-			Volume:      o.Volume,
-			Price: func() float64 {
-				bestBid, bestBidExists := bestBids[o.ID]
-				bestAsk, bestAskExists := bestAsks[o.ID]
-
-				// If the outcome has no bids and no asks, the approximate prediction is 0
-				if !bestBidExists && !bestAskExists {
-					return 0.0
-				}
-				// If the outcome has bids and asks, use the weighted avg of
-				// the best bid and the best ask
-				if bestBidExists && bestAskExists {
-					return float64(((bestBid.Price * bestBid.Amount) + (bestAsk.Price * bestAsk.Amount)) /
-						(bestBid.Amount + bestAsk.Amount))
-				}
-				// If the outcome has bids and no asks, use the best bid
-				if bestBidExists {
-					return float64(bestBid.Price)
-				}
-				// If the outcome has no bids and has asks, use the best ask
-				return float64(bestAsk.Price)
-			}(),
-		})
-	}
-
-	// Sort outcomes by best approximate prediction and generate Prediction types
-	sort.Slice(liquidityPricedOutcomes, func(i, j int) bool {
-		return outcomes[i].Price > outcomes[j].Price
-	})
 	predictions := []*markets.Prediction{}
 	for _, o := range outcomes {
-		predictions = append(predictions, &markets.Prediction{
+		prediction := &markets.Prediction{
 			Name:      o.Description,
-			Percent:   float32(o.Price * 100),
 			OutcomeId: o.ID,
-		})
+		}
+		// Use the prices of the trades if there is volume,
+		// otherwise derive the prediction using liquidity
+		price, method := o.Price, markets.PredictionMethod_LAST_TRADE_PRICE
+		if m.Volume <= 0 {
+			price, method = getCategoricalLiquidityPrice(bestBids[o.ID], bestAsks[o.ID])
+		}
+		prediction.Percent = float32(price * 100)
+		prediction.Method = method
+		predictions = append(predictions, prediction)
 	}
+	normalizeCategoricalPredictions(predictions)
+
+	// Sort the predictions so that they are sent to the client
+	// in order of most probable to least probable
+	sort.SliceStable(predictions, func(i, j int) bool {
+		return predictions[i].Percent > predictions[j].Percent
+	})
 	return predictions
 }
 
+func getCategoricalLiquidityPrice(bestBid, bestAsk *markets.LiquidityAtPrice) (float64, markets.PredictionMethod) {
+	// If the outcome has no bids and no asks, the approximate prediction is 0
+	if bestBid == nil && bestAsk == nil {
+		return 0.0, markets.PredictionMethod_NO_PRICE
+	}
+	// If the outcome has bids and asks, use the weighted avg of
+	// the best bid and the best ask
+	if bestBid != nil && bestAsk != nil {
+		return float64(((bestBid.Price * bestBid.Amount) + (bestAsk.Price * bestAsk.Amount)) /
+			(bestBid.Amount + bestAsk.Amount)), markets.PredictionMethod_ORDER_BOOK_MIDPOINT
+	}
+	// If the outcome has bids and no asks, use the best bid
+	if bestBid != nil {
+		return float64(bestBid.Price), markets.PredictionMethod_BEST_BID
+	}
+	// If the outcome has no bids and has asks, use the best ask
+	return float64(bestAsk.Price), markets.PredictionMethod_BEST_ASK
+}
+
 func getScalarPredictions(m *Market, outcomes []*Outcome, bestBids, bestAsks map[uint64]*markets.LiquidityAtPrice) []*markets.Prediction {
 	if len(outcomes) != 2 {
 		logrus.WithField("outcomeInfos", outcomes).Errorf("`getScalarPredictions` was called without 2 `OutcomeInfo` arguments")
@@ -353,6 +343,7 @@ func getScalarPredictions(m *Market, outcomes []*Outcome, bestBids, bestAsks map
 				Name:      m.ScalarDenomination,
 				Value:     float32(value),
 				OutcomeId: 1,
+				Method:    markets.PredictionMethod_LAST_TRADE_PRICE,
 			},
 		}
 	}
@@ -364,28 +355,35 @@ func getScalarPredictions(m *Market, outcomes []*Outcome, bestBids, bestAsks map
 	bestUpperAsk, upperAskExists := bestAsks[1]
 
 	var value float32 = 0.0
+	method := markets.PredictionMethod_NO_PRICE
 	if upperBidExists && upperAskExists {
 		// If UPPER bids and UPPER asks, use the weighted average
 		// of the best UPPER bid and the best UPPER ask
 		value = ((bestUpperBid.Price * bestUpperAsk.Amount) + (bestUpperAsk.Price * bestUpperAsk.Amount)) / (bestUpperBid.Amount + bestUpperAsk.Amount)
+		method = markets.PredictionMethod_ORDER_BOOK_MIDPOINT
 	} else if upperBidExists && !upperAskExists {
 		// If UPPER bids and no UPPER asks, use the best UPPER bid
 		value = bestUpperBid.Price
+		method = markets.PredictionMethod_BEST_BID
 	} else if !upperBidExists && upperAskExists {
 		// If no UPPER bids and UPPER asks, use the best UPPER ask
 		value = bestUpperAsk.Price
+		method = markets.PredictionMethod_BEST_ASK
 	} else if lowerBidExists && lowerAskExists {
 		// If LOWER bids and LOWER asks, use
 		// Market.MaxPrice + Market.MinPrice - (weighted avg of best LOWER bid and best LOWER ask)
 		value = float32(m.MaxPrice+m.MinPrice) - ((bestLowerBid.Price*bestLowerBid.Amount)+(bestLowerAsk.Price*bestLowerAsk.Price*bestLowerAsk.Amount))/(bestLowerBid.Amount+bestLowerAsk.Amount)
+		method = markets.PredictionMethod_ORDER_BOOK_MIDPOINT
 	} else if lowerBidExists && !lowerAskExists {
 		// If LOWER bids and no LOWER asks, use
 		// Market.MaxPrice + Market.MinPrice - (best LOWER bid)
 		value = float32(m.MaxPrice+m.MinPrice) - bestLowerBid.Price
+		method = markets.PredictionMethod_BEST_BID
 	} else if !lowerBidExists && lowerAskExists {
 		// If no LOWER bids and LOWER asks, use
 		// Market.MaxPrice + Market.MinPrice - (best LOWER ask)
 		value = float32(m.MaxPrice+m.MinPrice) - bestLowerAsk.Price
+		method = markets.PredictionMethod_BEST_ASK
 	}
 
 	return []*markets.Prediction{
@@ -393,6 +391,7 @@ func getScalarPredictions(m *Market, outcomes []*Outcome, bestBids, bestAsks map
 			Name:      m.ScalarDenomination,
 			Value:     float32(value),
 			OutcomeId: 1,
+			Method:    method,
 		},
 	}
 }
@@ -272,3 +272,46 @@ func TestGetAsks(t *testing.T) {
 		})
 	}
 }
+
+func TestGetBidLevels(t *testing.T) {
+	orders := &augur.GetOrdersResponse_OrdersByOrderIdByOrderTypeByOutcome{
+		OrdersByOrderIdByOrderTypeByOutcome: map[uint64]*augur.GetOrdersResponse_OrdersByOrderIdByOrderType{
+			1: &augur.GetOrdersResponse_OrdersByOrderIdByOrderType{
+				BuyOrdersByOrderId: &augur.GetOrdersResponse_OrdersByOrderId{
+					OrdersByOrderId: map[string]*augur.Order{
+						uuid.New(): &augur.Order{
+							Price:               "0.1235",
+							Amount:              "0.3333",
+							FullPrecisionPrice:  "0.123456789012345678",
+							FullPrecisionAmount: "0.333333333333333333",
+							OrderState:          augur.OrderState_OPEN,
+						},
+						uuid.New(): &augur.Order{
+							Price:               "0.1235",
+							Amount:              "0.6667",
+							FullPrecisionPrice:  "0.123456789012345678",
+							FullPrecisionAmount: "0.666666666666666667",
+							OrderState:          augur.OrderState_OPEN,
+						},
+						// Orders without full precision fall back to the rounded values
+						uuid.New(): &augur.Order{
+							Price:      "0.1",
+							Amount:     "2",
+							OrderState: augur.OrderState_OPEN,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	levels, err := markets.GetBidLevels(orders)
+	assert.Nil(t, err)
+	assert.Len(t, levels[1], 2)
+	assert.Equal(t, "61728394506172839/500000000000000000", levels[1][0].Price.RatString())
+	assert.Equal(t, "1", levels[1][0].Amount.RatString())
+	assert.Equal(t, uint64(2), levels[1][0].Orders)
+	assert.Equal(t, "1/10", levels[1][1].Price.RatString())
+	assert.Equal(t, "2", levels[1][1].Amount.RatString())
+	assert.Equal(t, uint64(1), levels[1][1].Orders)
+}
@@ -0,0 +1,111 @@
+package markets
+
+import (
+	"math"
+
+	"github.com/stateshape/augur-analyzer/pkg/proto/markets"
+)
+
+const (
+	// Bisection bounds and iterations when solving for the power method exponent
+	powerMethodMaxExponent = 64
+	powerMethodIterations  = 100
+)
+
+// normalizeCategoricalPredictions sets the proportional and power normalized
+// percents of the predictions of a categorical market so each sums to 100
+func normalizeCategoricalPredictions(predictions []*markets.Prediction) {
+	probabilities := make([]float64, len(predictions))
+	for i, prediction := range predictions {
+		probabilities[i] = math.Max(0, float64(prediction.Percent)/100)
+	}
+	proportional := NormalizeProportional(probabilities)
+	power := NormalizePower(probabilities)
+	for i, prediction := range predictions {
+		prediction.ProportionalPercent = float32(proportional[i] * 100)
+		prediction.PowerPercent = float32(power[i] * 100)
+	}
+}
+
+// getOverround returns the sum of the implied probabilities of the outcomes of
+// a categorical market minus 1, and 0 for other markets
+func getOverround(marketType markets.MarketType, predictions []*markets.Prediction) float32 {
+	if marketType != markets.MarketType_CATEGORICAL || len(predictions) == 0 {
+		return 0
+	}
+	total := 0.0
+	for _, prediction := range predictions {
+		total += float64(prediction.Percent) / 100
+	}
+	return float32(total - 1)
+}
+
+// NormalizeProportional divides every probability by their sum. Returns the
+// probabilities unchanged if they sum to 0.
+func NormalizeProportional(probabilities []float64) []float64 {
+	total := 0.0
+	for _, p := range probabilities {
+		total += p
+	}
+	normalized := make([]float64, len(probabilities))
+	for i, p := range probabilities {
+		if total <= 0 {
+			normalized[i] = p
+			continue
+		}
+		normalized[i] = p / total
+	}
+	return normalized
+}
+
+// NormalizePower raises every probability to the exponent k which makes them
+// sum to 1. Unlike the proportional method this removes more of the overround
+// from unlikely outcomes, which tend to be overpriced. Probabilities must be
+// within [0, 1]; they are returned unchanged if they sum to 0.
+func NormalizePower(probabilities []float64) []float64 {
+	normalized := make([]float64, len(probabilities))
+	positive := 0
+	for _, p := range probabilities {
+		if p > 0 {
+			positive++
+		}
+	}
+	if positive == 0 {
+		copy(normalized, probabilities)
+		return normalized
+	}
+
+	powered := func(k float64) float64 {
+		total := 0.0
+		for _, p := range probabilities {
+			if p > 0 {
+				total += math.Pow(math.Min(p, 1), k)
+			}
+		}
+		return total
+	}
+
+	// The sum of the powered probabilities decreases with k, so bisect
+	// for the exponent at which it equals 1
+	low, high := 0.0, float64(powerMethodMaxExponent)
+	if positive == 1 || powered(high) > 1 {
+		// Only a single outcome is priced, or every priced
+		// outcome is certain, so the exponent is unbounded
+		return NormalizeProportional(probabilities)
+	}
+	for i := 0; i < powerMethodIterations; i++ {
+		mid := (low + high) / 2
+		if powered(mid) > 1 {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+	k := (low + high) / 2
+	for i, p := range probabilities {
+		if p > 0 {
+			normalized[i] = math.Pow(math.Min(p, 1), k)
+		}
+	}
+	return normalized
+}
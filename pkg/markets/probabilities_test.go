@@ -0,0 +1,57 @@
+package markets_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stateshape/augur-analyzer/pkg/markets"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeProportional(t *testing.T) {
+	normalized := markets.NormalizeProportional([]float64{0.5, 0.4, 0.2})
+	assert.InDeltaSlice(t, []float64{0.5 / 1.1, 0.4 / 1.1, 0.2 / 1.1}, normalized, 1e-9)
+
+	assert.Equal(t, []float64{0, 0}, markets.NormalizeProportional([]float64{0, 0}))
+}
+
+func TestNormalizePower(t *testing.T) {
+	cases := []struct {
+		Name          string
+		Probabilities []float64
+	}{
+		{Name: "Overround", Probabilities: []float64{0.5, 0.4, 0.2}},
+		{Name: "Underround", Probabilities: []float64{0.3, 0.3, 0.2}},
+		{Name: "Unpriced outcome", Probabilities: []float64{0.6, 0.6, 0}},
+	}
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			normalized := markets.NormalizePower(c.Probabilities)
+			total := 0.0
+			for _, p := range normalized {
+				total += p
+			}
+			assert.InDelta(t, 1, total, 1e-9)
+
+			// Every probability is raised to the same exponent
+			k := math.Log(normalized[0]) / math.Log(c.Probabilities[0])
+			for i, p := range c.Probabilities {
+				if p == 0 {
+					assert.Equal(t, float64(0), normalized[i])
+					continue
+				}
+				assert.InDelta(t, math.Pow(p, k), normalized[i], 1e-9)
+			}
+		})
+	}
+
+	// Unlikely outcomes lose more of the overround than with the proportional method
+	probabilities := []float64{0.7, 0.2, 0.2}
+	power, proportional := markets.NormalizePower(probabilities), markets.NormalizeProportional(probabilities)
+	assert.True(t, power[0] > proportional[0])
+	assert.True(t, power[1] < proportional[1])
+
+	assert.Equal(t, []float64{1, 0}, markets.NormalizePower([]float64{0.4, 0}))
+	assert.Equal(t, []float64{0, 0}, markets.NormalizePower([]float64{0, 0}))
+}
@@ -0,0 +1,85 @@
+package markets
+
+import (
+	"math"
+
+	"github.com/stateshape/augur-analyzer/pkg/proto/markets"
+)
+
+// Distances from the mid price, in percent of the price range, at which the
+// cumulative depth of an order book is measured
+var DepthPercentsFromMid = []uint32{1, 2, 5, 10}
+
+// GetOrderBookMetrics computes the mid price, spread and depth of the order
+// book of every outcome with at least one order. Bids must be sorted from the
+// highest price and asks from the lowest, as returned by GetBids and GetAsks.
+func GetOrderBookMetrics(bids, asks map[uint64]*markets.ListLiquidityAtPrice, minPrice, maxPrice float64) map[uint64]*markets.OutcomeOrderBookMetrics {
+	metricsByOutcome := map[uint64]*markets.OutcomeOrderBookMetrics{}
+	priceRange := maxPrice - minPrice
+	if priceRange <= 0 {
+		return metricsByOutcome
+	}
+
+	outcomes := map[uint64]struct{}{}
+	for outcome := range bids {
+		outcomes[outcome] = struct{}{}
+	}
+	for outcome := range asks {
+		outcomes[outcome] = struct{}{}
+	}
+
+	for outcome := range outcomes {
+		outcomeBids, outcomeAsks := levels(bids[outcome]), levels(asks[outcome])
+		if len(outcomeBids) == 0 && len(outcomeAsks) == 0 {
+			continue
+		}
+
+		metrics := &markets.OutcomeOrderBookMetrics{
+			DepthByPercentFromMid: map[uint32]*markets.OrderBookDepth{},
+		}
+		var reference float64
+		switch {
+		case len(outcomeBids) > 0 && len(outcomeAsks) > 0:
+			bestBid, bestAsk := float64(outcomeBids[0].Price), float64(outcomeAsks[0].Price)
+			reference = (bestBid + bestAsk) / 2
+			metrics.TwoSided = true
+			metrics.MidPrice = float32(reference)
+			metrics.Spread = float32(bestAsk - bestBid)
+			metrics.RelativeSpread = float32((bestAsk - bestBid) / priceRange)
+		case len(outcomeBids) > 0:
+			reference = float64(outcomeBids[0].Price)
+		default:
+			reference = float64(outcomeAsks[0].Price)
+		}
+
+		for _, percent := range DepthPercentsFromMid {
+			// Tolerate float32 prices landing just outside the window
+			distance := float64(percent)/100*priceRange + 1e-6*priceRange
+			depth := &markets.OrderBookDepth{}
+			for _, lap := range outcomeBids {
+				if math.Abs(float64(lap.Price)-reference) > distance {
+					break
+				}
+				depth.BidShares += lap.Amount
+				depth.BidValue += lap.Amount * float32(float64(lap.Price)-minPrice)
+			}
+			for _, lap := range outcomeAsks {
+				if math.Abs(float64(lap.Price)-reference) > distance {
+					break
+				}
+				depth.AskShares += lap.Amount
+				depth.AskValue += lap.Amount * float32(float64(lap.Price)-minPrice)
+			}
+			metrics.DepthByPercentFromMid[percent] = depth
+		}
+		metricsByOutcome[outcome] = metrics
+	}
+	return metricsByOutcome
+}
+
+func levels(list *markets.ListLiquidityAtPrice) []*markets.LiquidityAtPrice {
+	if list == nil {
+		return nil
+	}
+	return list.LiquidityAtPrice
+}
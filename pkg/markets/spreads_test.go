@@ -0,0 +1,73 @@
+package markets_test
+
+import (
+	"testing"
+
+	"github.com/stateshape/augur-analyzer/pkg/markets"
+	protomarkets "github.com/stateshape/augur-analyzer/pkg/proto/markets"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetOrderBookMetrics(t *testing.T) {
+	list := func(laps ...*protomarkets.LiquidityAtPrice) *protomarkets.ListLiquidityAtPrice {
+		return &protomarkets.ListLiquidityAtPrice{LiquidityAtPrice: laps}
+	}
+
+	t.Run("Yes/No", func(t *testing.T) {
+		bids := map[uint64]*protomarkets.ListLiquidityAtPrice{
+			1: list(&protomarkets.LiquidityAtPrice{Price: 0.49, Amount: 10}, &protomarkets.LiquidityAtPrice{Price: 0.45, Amount: 20}),
+			0: list(),
+		}
+		asks := map[uint64]*protomarkets.ListLiquidityAtPrice{
+			1: list(&protomarkets.LiquidityAtPrice{Price: 0.51, Amount: 5}, &protomarkets.LiquidityAtPrice{Price: 0.7, Amount: 50}),
+		}
+		metrics := markets.GetOrderBookMetrics(bids, asks, 0, 1)
+		assert.Equal(t, 1, len(metrics))
+
+		yes := metrics[1]
+		assert.True(t, yes.TwoSided)
+		assert.InDelta(t, 0.5, yes.MidPrice, 1e-6)
+		assert.InDelta(t, 0.02, yes.Spread, 1e-6)
+		assert.InDelta(t, 0.02, yes.RelativeSpread, 1e-6)
+		assert.Equal(t, float32(10), yes.DepthByPercentFromMid[1].BidShares)
+		assert.Equal(t, float32(5), yes.DepthByPercentFromMid[1].AskShares)
+		assert.Equal(t, float32(30), yes.DepthByPercentFromMid[5].BidShares)
+		assert.InDelta(t, 10*0.49+20*0.45, yes.DepthByPercentFromMid[5].BidValue, 1e-5)
+		assert.Equal(t, float32(5), yes.DepthByPercentFromMid[10].AskShares)
+	})
+
+	t.Run("Scalar", func(t *testing.T) {
+		bids := map[uint64]*protomarkets.ListLiquidityAtPrice{
+			1: list(&protomarkets.LiquidityAtPrice{Price: 150, Amount: 2}, &protomarkets.LiquidityAtPrice{Price: 140, Amount: 1}),
+		}
+		asks := map[uint64]*protomarkets.ListLiquidityAtPrice{
+			1: list(&protomarkets.LiquidityAtPrice{Price: 170, Amount: 3}),
+		}
+		metrics := markets.GetOrderBookMetrics(bids, asks, 100, 300)
+
+		m := metrics[1]
+		assert.InDelta(t, 160, m.MidPrice, 1e-4)
+		assert.InDelta(t, 20, m.Spread, 1e-4)
+		assert.InDelta(t, 0.1, m.RelativeSpread, 1e-6)
+		// 5% of the price range is 10
+		assert.Equal(t, float32(2), m.DepthByPercentFromMid[5].BidShares)
+		assert.Equal(t, float32(3), m.DepthByPercentFromMid[5].AskShares)
+		assert.InDelta(t, 2*50, m.DepthByPercentFromMid[5].BidValue, 1e-4)
+		assert.InDelta(t, 3*70, m.DepthByPercentFromMid[5].AskValue, 1e-4)
+		assert.Equal(t, float32(3), m.DepthByPercentFromMid[10].BidShares)
+		assert.Equal(t, float32(0), m.DepthByPercentFromMid[1].BidShares)
+	})
+
+	t.Run("One sided", func(t *testing.T) {
+		asks := map[uint64]*protomarkets.ListLiquidityAtPrice{
+			2: list(&protomarkets.LiquidityAtPrice{Price: 0.3, Amount: 4}, &protomarkets.LiquidityAtPrice{Price: 0.31, Amount: 1}),
+		}
+		metrics := markets.GetOrderBookMetrics(nil, asks, 0, 1)
+
+		m := metrics[2]
+		assert.False(t, m.TwoSided)
+		assert.Equal(t, float32(0), m.MidPrice)
+		assert.Equal(t, float32(5), m.DepthByPercentFromMid[1].AskShares)
+	})
+}
@@ -0,0 +1,93 @@
+package markets
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/stateshape/augur-analyzer/pkg/currency"
+	"github.com/stateshape/augur-analyzer/pkg/markets/liquidity"
+	"github.com/stateshape/augur-analyzer/pkg/proto/markets"
+)
+
+// GetLiquidityTrace traces the retention ratio of the allowance on the order
+// books of a market, net of fees when the config models them
+func GetLiquidityTrace(calculator liquidity.Calculator, md *MarketData, config *LiquidityConfig, allowance currency.Ether) (*markets.LiquidityTrace, error) {
+	if md.Info == nil {
+		return nil, fmt.Errorf("`GetLiquidityTrace` requires a non nil MarketInfo")
+	}
+	bids, err := GetBidLevels(md.Orders)
+	if err != nil {
+		return nil, err
+	}
+	asks, err := GetAskLevels(md.Orders)
+	if err != nil {
+		return nil, err
+	}
+	minPrice, err := liquidity.ParseDecimal(md.Info.MinPrice)
+	if err != nil {
+		return nil, err
+	}
+	maxPrice, err := liquidity.ParseDecimal(md.Info.MaxPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	market := liquidity.MarketData{
+		MinPrice: minPrice,
+		MaxPrice: maxPrice,
+	}
+	if config.Fees {
+		if market.SettlementFee, err = GetSettlementFee(md.Info); err != nil {
+			return nil, err
+		}
+	}
+
+	books, outcomeIDs := getOutcomeOrderBooks(md.Info, bids, asks)
+	_, trace := calculator.TraceLiquidityRetentionRatio(allowance, market, books)
+	translated := translateLiquidityTrace(md.Info.Id, trace, outcomeIDs)
+	if market.SettlementFee != nil {
+		translated.SettlementFee, _ = market.SettlementFee.Float32()
+	}
+	return translated, nil
+}
+
+func translateLiquidityTrace(marketID string, trace *liquidity.RetentionTrace, outcomeIDs []uint64) *markets.LiquidityTrace {
+	float := func(r *big.Rat) float32 {
+		f, _ := r.Float32()
+		return f
+	}
+
+	translated := &markets.LiquidityTrace{
+		MarketId:       marketID,
+		Allowance:      float(trace.Allowance),
+		CompleteSets:   float(trace.CompleteSets),
+		Proceeds:       float(trace.Proceeds),
+		RetentionRatio: float(trace.RetentionRatio),
+		Increments:     []*markets.LiquidityTraceIncrement{},
+	}
+	for _, increment := range trace.Increments {
+		translatedIncrement := &markets.LiquidityTraceIncrement{
+			Shares:                float(increment.Shares),
+			Strategy:              markets.LiquidityStrategy_SINGLE_BOOK,
+			Fills:                 []*markets.LiquidityTraceFill{},
+			Proceeds:              float(increment.Proceeds),
+			RemainingCompleteSets: float(increment.RemainingCompleteSets),
+		}
+		if increment.Strategy == liquidity.StrategyEachBook {
+			translatedIncrement.Strategy = markets.LiquidityStrategy_EACH_BOOK
+		} else {
+			translatedIncrement.OutcomeId = outcomeIDs[increment.Book]
+		}
+		for _, fill := range increment.Fills {
+			translatedIncrement.Fills = append(translatedIncrement.Fills, &markets.LiquidityTraceFill{
+				OutcomeId:    outcomeIDs[fill.Book],
+				ClosingShort: fill.ClosingShort,
+				Shares:       float(fill.Shares),
+				Proceeds:     float(fill.Proceeds),
+				Levels:       liquidity.LiquidityAtPriceFromLevels(fill.Levels),
+			})
+		}
+		translated.Increments = append(translated.Increments, translatedIncrement)
+	}
+	return translated
+}
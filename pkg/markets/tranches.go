@@ -0,0 +1,188 @@
+package markets
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/stateshape/augur-analyzer/pkg/currency"
+	"github.com/stateshape/augur-analyzer/pkg/markets/liquidity"
+	"github.com/stateshape/augur-analyzer/pkg/proto/markets"
+
+	"github.com/sirupsen/logrus"
+)
+
+// LiquidityTranches are the allowances liquidity metrics are computed at
+type LiquidityTranches struct {
+	MillietherTranches []uint64 `json:"millietherTranches"`
+	// Converted to ETH at the exchange rate of every block
+	USDTranches []uint64 `json:"usdTranches"`
+}
+
+// LiquidityConfig selects the tranches of the liquidity metrics of markets
+type LiquidityConfig struct {
+	LiquidityTranches
+	// Tranches of market types which do not use the default ones
+	TranchesByMarketType map[markets.MarketType]LiquidityTranches
+	// Compute retention ratios net of settlement fees as well
+	Fees bool
+}
+
+// DefaultLiquidityConfig computes liquidity metrics at liquidity.Tranches,
+// gross and net of fees
+func DefaultLiquidityConfig() *LiquidityConfig {
+	config := &LiquidityConfig{
+		TranchesByMarketType: map[markets.MarketType]LiquidityTranches{},
+		Fees:                 true,
+	}
+	for _, tranche := range liquidity.Tranches {
+		config.MillietherTranches = append(config.MillietherTranches, tranche.Uint64())
+	}
+	return config
+}
+
+// ParseLiquidityConfig creates a config from comma separated lists of
+// milliether and USD tranches
+func ParseLiquidityConfig(millietherTranches, usdTranches string) (*LiquidityConfig, error) {
+	config := &LiquidityConfig{
+		TranchesByMarketType: map[markets.MarketType]LiquidityTranches{},
+	}
+	var err error
+	if config.MillietherTranches, err = parseTranches(millietherTranches); err != nil {
+		return nil, err
+	}
+	if config.USDTranches, err = parseTranches(usdTranches); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// LoadLiquidityConfig reads a JSON liquidity config from a file. Fields
+// missing from the file are taken from the fallback config.
+//
+//	{
+//	  "millietherTranches": [1000, 10000],
+//	  "usdTranches": [100],
+//	  "marketTypes": {
+//	    "categorical": {"millietherTranches": [100, 1000]}
+//	  }
+//	}
+func LoadLiquidityConfig(path string, fallback *LiquidityConfig) (*LiquidityConfig, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	file := struct {
+		MillietherTranches []uint64                     `json:"millietherTranches"`
+		USDTranches        []uint64                     `json:"usdTranches"`
+		MarketTypes        map[string]LiquidityTranches `json:"marketTypes"`
+		// Deprecated, complete sets are liquidated exactly
+		SellingIncrement json.RawMessage `json:"sellingIncrement"`
+	}{}
+	if err := json.Unmarshal(content, &file); err != nil {
+		return nil, err
+	}
+	if file.SellingIncrement != nil {
+		logrus.WithField("path", path).Warnf("Ignoring `sellingIncrement` of liquidity config, complete sets are liquidated exactly")
+	}
+
+	config := &LiquidityConfig{
+		LiquidityTranches:    fallback.LiquidityTranches,
+		TranchesByMarketType: map[markets.MarketType]LiquidityTranches{},
+		Fees:                 fallback.Fees,
+	}
+	if file.MillietherTranches != nil {
+		config.MillietherTranches = file.MillietherTranches
+	}
+	if file.USDTranches != nil {
+		config.USDTranches = file.USDTranches
+	}
+	if err := config.LiquidityTranches.validate(); err != nil {
+		return nil, err
+	}
+	for name, tranches := range file.MarketTypes {
+		marketType, ok := markets.MarketType_value[strings.ToUpper(name)]
+		if !ok {
+			return nil, fmt.Errorf("Unknown market type in liquidity config: %s", name)
+		}
+		if err := tranches.validate(); err != nil {
+			return nil, err
+		}
+		config.TranchesByMarketType[markets.MarketType(marketType)] = tranches
+	}
+	return config, nil
+}
+
+func (t LiquidityTranches) validate() error {
+	for _, tranche := range append(append([]uint64{}, t.MillietherTranches...), t.USDTranches...) {
+		if tranche == 0 {
+			return fmt.Errorf("Liquidity tranches must be positive")
+		}
+	}
+	return nil
+}
+
+// TranchesFor returns the tranches of a market type
+func (c *LiquidityConfig) TranchesFor(marketType markets.MarketType) LiquidityTranches {
+	if tranches, ok := c.TranchesByMarketType[marketType]; ok {
+		return tranches
+	}
+	return c.LiquidityTranches
+}
+
+// Summary describes the config, with every tranche converted at the rate
+func (c *LiquidityConfig) Summary(ethusd float64) *markets.LiquidityMetricsConfig {
+	summary := &markets.LiquidityMetricsConfig{
+		MillietherTranches:     c.MillietherTranches,
+		UsdTranches:            c.USDTranches,
+		TranchesByMarketType:   map[string]*markets.LiquidityTranches{},
+		EthUsd:                 float32(ethusd),
+		UsdByMillietherTranche: map[uint64]float32{},
+		MillietherByUsdTranche: map[uint64]float32{},
+		Fees:                   c.Fees,
+	}
+	convert := func(tranches LiquidityTranches) {
+		for _, tranche := range tranches.MillietherTranches {
+			summary.UsdByMillietherTranche[tranche] = float32(currency.Milliether(tranche).Ether().Float64() * ethusd)
+		}
+		for _, tranche := range tranches.USDTranches {
+			if ethusd > 0 {
+				summary.MillietherByUsdTranche[tranche] = float32(usdToEther(tranche, ethusd).Milliether())
+			}
+		}
+	}
+	convert(c.LiquidityTranches)
+	for marketType, tranches := range c.TranchesByMarketType {
+		summary.TranchesByMarketType[marketType.String()] = &markets.LiquidityTranches{
+			MillietherTranches: tranches.MillietherTranches,
+			UsdTranches:        tranches.USDTranches,
+		}
+		convert(tranches)
+	}
+	return summary
+}
+
+func usdToEther(usd uint64, ethusd float64) currency.Ether {
+	return currency.Ether(float64(usd) / ethusd)
+}
+
+func parseTranches(list string) ([]uint64, error) {
+	tranches := []uint64{}
+	for _, field := range strings.Split(list, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		tranche, err := strconv.ParseUint(field, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		if tranche == 0 {
+			return nil, fmt.Errorf("Liquidity tranches must be positive")
+		}
+		tranches = append(tranches, tranche)
+	}
+	return tranches, nil
+}
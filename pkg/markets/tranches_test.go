@@ -0,0 +1,58 @@
+package markets_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stateshape/augur-analyzer/pkg/markets"
+	protomarkets "github.com/stateshape/augur-analyzer/pkg/proto/markets"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLiquidityConfig(t *testing.T) {
+	fallback, err := markets.ParseLiquidityConfig("1000, 10000", "100")
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{1000, 10000}, fallback.MillietherTranches)
+	assert.Equal(t, []uint64{100}, fallback.USDTranches)
+
+	_, err = markets.ParseLiquidityConfig("1000,0", "")
+	assert.NotNil(t, err)
+	_, err = markets.ParseLiquidityConfig("1000", "x")
+	assert.NotNil(t, err)
+
+	dir, err := ioutil.TempDir("", "liquidity")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "liquidity.json")
+	assert.Nil(t, ioutil.WriteFile(path, []byte(`{
+		"usdTranches": [50, 500],
+		"marketTypes": {"categorical": {"millietherTranches": [100]}}
+	}`), 0644))
+
+	config, err := markets.LoadLiquidityConfig(path, fallback)
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{1000, 10000}, config.MillietherTranches)
+	assert.Equal(t, []uint64{50, 500}, config.USDTranches)
+	assert.Equal(t, []uint64{100}, config.TranchesFor(protomarkets.MarketType_CATEGORICAL).MillietherTranches)
+	assert.Equal(t, []uint64{1000, 10000}, config.TranchesFor(protomarkets.MarketType_YESNO).MillietherTranches)
+
+	summary := config.Summary(200)
+	assert.Equal(t, float32(200), summary.EthUsd)
+	assert.Equal(t, float32(200), summary.UsdByMillietherTranche[1000])
+	assert.Equal(t, float32(20), summary.UsdByMillietherTranche[100])
+	assert.Equal(t, float32(250), summary.MillietherByUsdTranche[50])
+	assert.Equal(t, []uint64{100}, summary.TranchesByMarketType["CATEGORICAL"].MillietherTranches)
+
+	// The selling increment is ignored
+	assert.Nil(t, ioutil.WriteFile(path, []byte(`{"sellingIncrement": 100, "usdTranches": [50]}`), 0644))
+	config, err = markets.LoadLiquidityConfig(path, fallback)
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{50}, config.USDTranches)
+
+	assert.Nil(t, ioutil.WriteFile(path, []byte(`{"marketTypes": {"binary": {}}}`), 0644))
+	_, err = markets.LoadLiquidityConfig(path, fallback)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,180 @@
+package markets
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/stateshape/augur-analyzer/pkg/proto/augur"
+	"github.com/stateshape/augur-analyzer/pkg/proto/markets"
+)
+
+const (
+	tradingActivityDay  = 24 * time.Hour
+	tradingActivityWeek = 7 * 24 * time.Hour
+
+	// Volume traded one half life ago counts half as much towards
+	// the trending score as volume traded right now
+	trendingScoreHalfLife = 24 * time.Hour
+)
+
+type TradingActivity struct {
+	VolumeLastDay  float64
+	VolumeLastWeek float64
+	ByOutcome      map[uint64]*OutcomeTradingActivity
+	TrendingScore  float64
+}
+
+type OutcomeTradingActivity struct {
+	VolumeLastDay       float64
+	VolumeLastWeek      float64
+	LastPrice           float64
+	PriceChangeLastDay  float64
+	PriceChangeLastWeek float64
+}
+
+type timestampedTrade struct {
+	Price     float64
+	Amount    float64
+	Timestamp time.Time
+}
+
+// GetTradingActivity derives rolling volume, price changes and a trending
+// score from a market's price history. Volume is denominated in ETH as the
+// amount of shares traded times the price paid above the market's min price.
+func GetTradingActivity(history *augur.MarketPriceHistory, minPrice, maxPrice float64, now time.Time) (*TradingActivity, error) {
+	activity := &TradingActivity{
+		ByOutcome: map[uint64]*OutcomeTradingActivity{},
+	}
+	if history == nil {
+		return activity, nil
+	}
+
+	// Price movement is measured relative to the range of the market so
+	// that scalar markets are comparable to yes/no and categorical markets
+	priceRange := maxPrice - minPrice
+	if priceRange <= 0 {
+		priceRange = 1
+	}
+
+	decayedVolume := 0.0
+	movement := 0.0
+	for outcome, list := range history.TimestampedPriceAmountByOutcome {
+		trades, err := parseTimestampedTrades(list)
+		if err != nil {
+			return nil, err
+		}
+		if len(trades) == 0 {
+			continue
+		}
+
+		outcomeActivity := &OutcomeTradingActivity{
+			LastPrice: trades[len(trades)-1].Price,
+		}
+		for _, trade := range trades {
+			age := now.Sub(trade.Timestamp)
+			if age < 0 {
+				age = 0
+			}
+			volume := trade.Amount * (trade.Price - minPrice)
+			if age <= tradingActivityDay {
+				outcomeActivity.VolumeLastDay += volume
+			}
+			if age <= tradingActivityWeek {
+				outcomeActivity.VolumeLastWeek += volume
+			}
+			decayedVolume += volume * math.Pow(0.5, age.Hours()/trendingScoreHalfLife.Hours())
+		}
+		outcomeActivity.PriceChangeLastDay = outcomeActivity.LastPrice - priceAt(trades, now.Add(-tradingActivityDay))
+		outcomeActivity.PriceChangeLastWeek = outcomeActivity.LastPrice - priceAt(trades, now.Add(-tradingActivityWeek))
+		movement += math.Abs(outcomeActivity.PriceChangeLastDay) / priceRange
+
+		activity.VolumeLastDay += outcomeActivity.VolumeLastDay
+		activity.VolumeLastWeek += outcomeActivity.VolumeLastWeek
+		activity.ByOutcome[outcome] = outcomeActivity
+	}
+
+	// A market moving across its whole range within a day
+	// doubles the weight of its recent volume
+	activity.TrendingScore = decayedVolume * (1 + movement)
+	return activity, nil
+}
+
+func parseTimestampedTrades(list *augur.ListTimestampedPriceAmount) ([]*timestampedTrade, error) {
+	trades := []*timestampedTrade{}
+	if list == nil {
+		return trades, nil
+	}
+	for _, tpa := range list.TimestampedPriceAmounts {
+		price, err := strconv.ParseFloat(tpa.Price, 64)
+		if err != nil {
+			return nil, err
+		}
+		amount, err := strconv.ParseFloat(tpa.Amount, 64)
+		if err != nil {
+			return nil, err
+		}
+		trades = append(trades, &timestampedTrade{
+			Price:     price,
+			Amount:    amount,
+			Timestamp: time.Unix(int64(tpa.Timestamp), 0),
+		})
+	}
+	sort.SliceStable(trades, func(i, j int) bool {
+		return trades[i].Timestamp.Before(trades[j].Timestamp)
+	})
+	return trades, nil
+}
+
+// priceAt returns the last price traded at or before `at`. If the outcome
+// first traded after `at`, the first traded price is used instead.
+func priceAt(trades []*timestampedTrade, at time.Time) float64 {
+	price := trades[0].Price
+	for _, trade := range trades {
+		if trade.Timestamp.After(at) {
+			break
+		}
+		price = trade.Price
+	}
+	return price
+}
+
+func translateTradingActivity(activity *TradingActivity, ethusd, btceth float64) (*markets.Price, *markets.Price, map[uint64]*markets.OutcomeTradingActivity) {
+	byOutcome := map[uint64]*markets.OutcomeTradingActivity{}
+	for outcome, oa := range activity.ByOutcome {
+		byOutcome[outcome] = &markets.OutcomeTradingActivity{
+			VolumeLastDay:       ethToPrice(oa.VolumeLastDay, ethusd, btceth),
+			VolumeLastWeek:      ethToPrice(oa.VolumeLastWeek, ethusd, btceth),
+			LastPrice:           float32(oa.LastPrice),
+			PriceChangeLastDay:  float32(oa.PriceChangeLastDay),
+			PriceChangeLastWeek: float32(oa.PriceChangeLastWeek),
+		}
+	}
+	return ethToPrice(activity.VolumeLastDay, ethusd, btceth), ethToPrice(activity.VolumeLastWeek, ethusd, btceth), byOutcome
+}
+
+func ethToPrice(eth, ethusd, btceth float64) *markets.Price {
+	return &markets.Price{
+		Eth: float32(eth),
+		Usd: float32(eth * ethusd),
+		Btc: float32(eth / btceth),
+	}
+}
+
+func deriveTrendingMarketIDs(ms []*markets.Market) []string {
+	trending := []*markets.Market{}
+	for _, m := range ms {
+		if m.TrendingScore > 0 {
+			trending = append(trending, m)
+		}
+	}
+	sort.SliceStable(trending, func(i, j int) bool {
+		return trending[i].TrendingScore > trending[j].TrendingScore
+	})
+	ids := []string{}
+	for _, m := range trending {
+		ids = append(ids, m.Id)
+	}
+	return ids
+}
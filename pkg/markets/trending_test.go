@@ -0,0 +1,58 @@
+package markets_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stateshape/augur-analyzer/pkg/markets"
+	"github.com/stateshape/augur-analyzer/pkg/proto/augur"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetTradingActivity(t *testing.T) {
+	now := time.Unix(1534000000, 0)
+	ago := func(d time.Duration) uint64 {
+		return uint64(now.Add(-d).Unix())
+	}
+
+	history := &augur.MarketPriceHistory{
+		TimestampedPriceAmountByOutcome: map[uint64]*augur.ListTimestampedPriceAmount{
+			1: &augur.ListTimestampedPriceAmount{
+				TimestampedPriceAmounts: []*augur.TimestampedPriceAmount{
+					{Price: "0.7", Amount: "10", Timestamp: ago(time.Hour)},
+					{Price: "0.4", Amount: "5", Timestamp: ago(3 * 24 * time.Hour)},
+					{Price: "0.2", Amount: "10", Timestamp: ago(30 * 24 * time.Hour)},
+				},
+			},
+		},
+	}
+
+	activity, err := markets.GetTradingActivity(history, 0, 1, now)
+	assert.Nil(t, err)
+	assert.InDelta(t, 7.0, activity.VolumeLastDay, 0.000001)
+	assert.InDelta(t, 9.0, activity.VolumeLastWeek, 0.000001)
+
+	outcome := activity.ByOutcome[1]
+	assert.InDelta(t, 0.7, outcome.LastPrice, 0.000001)
+	assert.InDelta(t, 0.3, outcome.PriceChangeLastDay, 0.000001)
+	assert.InDelta(t, 0.5, outcome.PriceChangeLastWeek, 0.000001)
+	assert.True(t, activity.TrendingScore > activity.VolumeLastDay)
+
+	stale, err := markets.GetTradingActivity(&augur.MarketPriceHistory{
+		TimestampedPriceAmountByOutcome: map[uint64]*augur.ListTimestampedPriceAmount{
+			1: &augur.ListTimestampedPriceAmount{
+				TimestampedPriceAmounts: []*augur.TimestampedPriceAmount{
+					{Price: "0.7", Amount: "10", Timestamp: ago(300 * 24 * time.Hour)},
+				},
+			},
+		},
+	}, 0, 1, now)
+	assert.Nil(t, err)
+	assert.Equal(t, 0.0, stale.VolumeLastWeek)
+	assert.True(t, stale.TrendingScore < activity.TrendingScore)
+
+	empty, err := markets.GetTradingActivity(nil, 0, 1, now)
+	assert.Nil(t, err)
+	assert.Equal(t, 0.0, empty.TrendingScore)
+}
@@ -4,17 +4,22 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/stateshape/augur-analyzer/pkg/alerts"
+	"github.com/stateshape/augur-analyzer/pkg/currency"
 	"github.com/stateshape/augur-analyzer/pkg/env"
 	"github.com/stateshape/augur-analyzer/pkg/gcloud"
 	"github.com/stateshape/augur-analyzer/pkg/markets/liquidity"
 	"github.com/stateshape/augur-analyzer/pkg/pricing"
 	"github.com/stateshape/augur-analyzer/pkg/proto/augur"
 	"github.com/stateshape/augur-analyzer/pkg/proto/markets"
+	"github.com/stateshape/augur-analyzer/pkg/timeseries"
+	"github.com/stateshape/augur-analyzer/pkg/webhooks"
 
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/sirupsen/logrus"
@@ -33,16 +38,36 @@ type Watcher struct {
 	AugurAPI            augur.MarketsApiClient
 	Writer              *Writer
 	LiquidityCalculator liquidity.Calculator
+	EventDetector       *EventDetector
+	Events              *EventFeed
+	Webhooks            *webhooks.Dispatcher
+	Alerts              *alerts.Engine
+	TimeSeries          *timeseries.Store
+	History             *UniverseHistoryRecorder
+	MarketMakers        *MarketMakerTracker
+	Predictions         PredictionConfig
+	Liquidity           *LiquidityConfig
+	PriceImpact         *PriceImpactConfig
+	Gas                 GasConfig
+	// Translates markets and computes the liquidity metrics of their tranches
+	Workers *WorkerPool
+
+	// Market data of the last processed block
+	latestMu sync.RWMutex
+	latest   *MarketsData
 }
 
 type MarketsData struct {
 	ByMarketID    map[string]*MarketData
 	ExchangeRates *ExchangeRates
+	// Gas price in wei, nil when the web3 client did not provide it
+	GasPrice *big.Int
 }
 
 type MarketData struct {
-	Info   *augur.MarketInfo
-	Orders *augur.GetOrdersResponse_OrdersByOrderIdByOrderTypeByOutcome
+	Info         *augur.MarketInfo
+	Orders       *augur.GetOrdersResponse_OrdersByOrderIdByOrderTypeByOutcome
+	PriceHistory *augur.MarketPriceHistory
 }
 
 type ExchangeRates struct {
@@ -51,10 +76,25 @@ type ExchangeRates struct {
 }
 
 func NewWatcher(pricingAPI pricing.PricingClient, web3API *ethclient.Client, augurAPI augur.MarketsApiClient, objectUploader *gcloud.ObjectUploader) *Watcher {
-	return &Watcher{pricingAPI, web3API, augurAPI, &Writer{
-		Bucket:         viper.GetString(env.GCloudStorageBucket),
-		ObjectUploader: objectUploader,
-	}, liquidity.NewCalculator()}
+	return &Watcher{
+		PricingAPI: pricingAPI,
+		Web3API:    web3API,
+		AugurAPI:   augurAPI,
+		Writer: &Writer{
+			Bucket:         viper.GetString(env.GCloudStorageBucket),
+			ObjectUploader: objectUploader,
+		},
+		LiquidityCalculator: liquidity.NewCalculator(),
+		EventDetector:       NewEventDetector(viper.GetFloat64(env.MarketEventPredictionThreshold)),
+		Events:              NewEventFeed(),
+		Alerts:              alerts.NewEngine(nil),
+		MarketMakers:        NewMarketMakerTracker(),
+		Predictions:         DefaultPredictionConfig,
+		Liquidity:           DefaultLiquidityConfig(),
+		PriceImpact:         DefaultPriceImpactConfig(),
+		Gas:                 DefaultGasConfig,
+		Workers:             NewWorkerPool(0),
+	}
 }
 
 func (w *Watcher) Watch() {
@@ -121,21 +161,37 @@ func (w *Watcher) process() error {
 			continue
 		}
 
-		m := []*markets.Market{}
-		for _, md := range marketsData.ByMarketID {
-			market, err := w.translateMarketInfoToMarket(md, marketsData.ExchangeRates.ETHUSD, marketsData.ExchangeRates.BTCETH)
+		w.latestMu.Lock()
+		w.latest = marketsData
+		w.latestMu.Unlock()
+
+		// Translate markets concurrently, in the order of their IDs
+		marketIDs := []string{}
+		for id := range marketsData.ByMarketID {
+			marketIDs = append(marketIDs, id)
+		}
+		sort.Strings(marketIDs)
+		translated := make([]*markets.Market, len(marketIDs))
+		w.Workers.Run(len(marketIDs), func(i int) {
+			md := marketsData.ByMarketID[marketIDs[i]]
+			market, err := w.translateMarketInfoToMarket(md, marketsData.ExchangeRates.ETHUSD, marketsData.ExchangeRates.BTCETH, marketsData.GasPrice)
 			if err != nil {
 				logrus.WithFields(logrus.Fields{
 					"block":         header.Number.String(),
-					"marketAddress": md.Info.Id,
+					"marketAddress": marketIDs[i],
 				}).WithError(err).Errorf("Failed to translate a market info into a market")
-
-				// Better to have a subset of the markets
-				// included in the summary for this block
-				// instead of none, so continue
-				continue
+				return
+			}
+			translated[i] = market
+		})
+		m := []*markets.Market{}
+		for _, market := range translated {
+			// Better to have a subset of the markets
+			// included in the summary for this block
+			// instead of none, so skip failed markets
+			if market != nil {
+				m = append(m, market)
 			}
-			m = append(m, market)
 		}
 
 		summary := &markets.MarketsSummary{
@@ -144,18 +200,20 @@ func (w *Watcher) process() error {
 			TotalMarketsCapitalization: deriveTotalMarketsCapitalization(m),
 			Markets:                    m,
 			GenerationTime:             uint64(time.Now().Unix()),
-			LiquidityMetricsConfig: &markets.LiquidityMetricsConfig{
-				MillietherTranches: func() []uint64 {
-					tranches := []uint64{}
-					for _, tranche := range liquidity.Tranches {
-						tranches = append(tranches, tranche.Uint64())
-					}
-					return tranches
-				}(),
-			},
+			TrendingMarketIds:          deriveTrendingMarketIDs(m),
+			LiquidityMetricsConfig:     w.Liquidity.Summary(marketsData.ExchangeRates.ETHUSD),
 		}
+		summary.LiquidityMetricsConfig.GasPriceGwei = getGasPriceGwei(marketsData.GasPrice)
+		summary.LiquidityMetricsConfig.GasPerFill = w.Gas.GasPerFill
+
+		events := w.EventDetector.Detect(summary.Block, m, marketsData)
+		w.Events.Append(events...)
+		logrus.WithField("block", header.Number.String()).Infof("Detected %d market events", len(events))
 
-		go DebugMarkets(marketsData, m)
+		fired := w.Alerts.Evaluate(summary.Block, time.Now(), m, marketsData.ExchangeRates.ETHUSD)
+		logrus.WithField("block", header.Number.String()).Infof("Fired %d alerts", len(fired))
+
+		go DebugMarkets(marketsData, m, w.LiquidityCalculator, w.Liquidity)
 
 		blocker := sync.WaitGroup{}
 
@@ -187,7 +245,113 @@ func (w *Watcher) process() error {
 		blocker.Add(1)
 		go func() {
 			defer blocker.Done()
-			details := constructMarketDetails(m, marketsData)
+			categories, tags := GetCategoryStatistics(m, marketsData)
+			categoriesSummary := &markets.CategoriesSummary{
+				Block:          summary.Block,
+				GenerationTime: summary.GenerationTime,
+				Categories:     categories,
+				Tags:           tags,
+			}
+			if err := w.Writer.WriteCategoriesSummary(categoriesSummary); err != nil {
+				logrus.WithError(err).Errorf("Failed to write categories summary to GCloud storage")
+				return
+			}
+			logrus.WithField("block", header.Number.String()).Infof("Successfully uploaded categories summary")
+		}()
+
+		blocker.Add(1)
+		go func() {
+			defer blocker.Done()
+			marketEvents := &markets.MarketEvents{
+				Block:          summary.Block,
+				GenerationTime: summary.GenerationTime,
+				Events:         w.Events.Since(0),
+			}
+			if err := w.Writer.WriteMarketEvents(marketEvents); err != nil {
+				logrus.WithError(err).Errorf("Failed to write market events to GCloud storage")
+				return
+			}
+			logrus.WithField("block", header.Number.String()).Infof("Successfully uploaded market events")
+		}()
+
+		if w.TimeSeries != nil {
+			blocker.Add(1)
+			go func() {
+				defer blocker.Done()
+				if err := w.TimeSeries.Append(summary.Block, time.Now(), m); err != nil {
+					logrus.WithError(err).Errorf("Failed to append market metrics to the time series store")
+					return
+				}
+				logrus.WithField("block", header.Number.String()).Infof("Successfully stored market metrics")
+			}()
+		}
+
+		blocker.Add(1)
+		go func() {
+			defer blocker.Done()
+			leaderboard := w.MarketMakers.Update(summary.Block, time.Unix(int64(summary.GenerationTime), 0), marketsData)
+			if err := w.Writer.WriteMarketMakerLeaderboard(leaderboard); err != nil {
+				logrus.WithError(err).Errorf("Failed to write market maker leaderboard to GCloud storage")
+				return
+			}
+			logrus.WithField("block", header.Number.String()).Infof("Successfully uploaded market maker leaderboard")
+		}()
+
+		blocker.Add(1)
+		go func() {
+			defer blocker.Done()
+			opportunities := &markets.ArbitrageOpportunities{
+				Block:          summary.Block,
+				GenerationTime: summary.GenerationTime,
+				Opportunities:  deriveArbitrageOpportunities(m, marketsData),
+			}
+			if err := w.Writer.WriteArbitrageOpportunities(opportunities); err != nil {
+				logrus.WithError(err).Errorf("Failed to write arbitrage opportunities to GCloud storage")
+				return
+			}
+			logrus.WithField("block", header.Number.String()).Infof("Successfully uploaded %d arbitrage opportunities", len(opportunities.Opportunities))
+		}()
+
+		if w.History != nil {
+			blocker.Add(1)
+			go func() {
+				defer blocker.Done()
+				snapshot := GetUniverseSnapshot(summary.Block, time.Unix(int64(summary.GenerationTime), 0), m, marketsData)
+				history, err := w.History.Record(snapshot, time.Now())
+				if err != nil {
+					logrus.WithError(err).Errorf("Failed to record universe history")
+					return
+				}
+				if err := w.Writer.WriteUniverseHistory(history); err != nil {
+					logrus.WithError(err).Errorf("Failed to write universe history to GCloud storage")
+					return
+				}
+				logrus.WithField("block", header.Number.String()).Infof("Successfully uploaded universe history")
+			}()
+		}
+
+		// Webhooks are optional
+		if w.Webhooks != nil {
+			blocker.Add(1)
+			go func() {
+				defer blocker.Done()
+				categoryByMarketID := map[string]string{}
+				for _, market := range m {
+					categoryByMarketID[market.Id] = market.Category
+				}
+				// Posted by the dispatcher, away from the processing of blocks
+				if err := w.Webhooks.Enqueue(summary.Block, events, categoryByMarketID, time.Now()); err != nil {
+					logrus.WithError(err).Errorf("Failed to enqueue market events for webhooks")
+					return
+				}
+				logrus.WithField("block", header.Number.String()).Infof("Enqueued webhook deliveries, %d pending", w.Webhooks.Pending())
+			}()
+		}
+
+		blocker.Add(1)
+		go func() {
+			defer blocker.Done()
+			details := w.constructMarketDetails(m, marketsData)
 			wg := sync.WaitGroup{}
 			for file, _ := range details {
 				object, detail := file, details[file]
@@ -209,7 +373,7 @@ func (w *Watcher) process() error {
 	}
 }
 
-func constructMarketDetails(ms []*markets.Market, msd *MarketsData) map[string]*markets.MarketDetailByMarketId {
+func (w *Watcher) constructMarketDetails(ms []*markets.Market, msd *MarketsData) map[string]*markets.MarketDetailByMarketId {
 	details := map[string]*markets.MarketDetailByMarketId{}
 	for _, market := range ms {
 		detail := &markets.MarketDetail{
@@ -218,6 +382,20 @@ func constructMarketDetails(ms []*markets.Market, msd *MarketsData) map[string]*
 		}
 		if md, ok := msd.ByMarketID[market.Id]; ok {
 			detail.MarketInfo = mapMarketInfo(md.Info)
+			analytics, err := GetOrderBookAnalytics(md.Orders, time.Now())
+			if err != nil {
+				logrus.WithField("marketAddress", market.Id).WithError(err).Errorf("Failed to compute order book analytics")
+			}
+			detail.OrderBookAnalytics = analytics
+			variants, err := GetPredictionVariants(md.Info, market.BestBids, market.BestAsks, md.PriceHistory, w.Predictions, time.Now())
+			if err != nil {
+				logrus.WithField("marketAddress", market.Id).WithError(err).Errorf("Failed to derive prediction variants")
+			}
+			detail.PredictionVariants = variants
+			if market.MarketType == markets.MarketType_SCALAR {
+				detail.ScalarDistribution = getMarketScalarDistribution(market, md)
+			}
+			detail.PriceImpactByOutcome = w.getMarketPriceImpactCurves(market, md)
 		}
 		filename := market.MarketDataSources.MarketDetailFileName
 		if _, ok := details[filename]; !ok {
@@ -230,6 +408,50 @@ func constructMarketDetails(ms []*markets.Market, msd *MarketsData) map[string]*
 	return details
 }
 
+func getMarketScalarDistribution(market *markets.Market, md *MarketData) *markets.ScalarDistribution {
+	minPrice, err := strconv.ParseFloat(md.Info.MinPrice, 64)
+	if err != nil {
+		logrus.WithField("marketAddress", market.Id).WithError(err).Errorf("Failed to parse market min price")
+		return nil
+	}
+	maxPrice, err := strconv.ParseFloat(md.Info.MaxPrice, 64)
+	if err != nil {
+		logrus.WithField("marketAddress", market.Id).WithError(err).Errorf("Failed to parse market max price")
+		return nil
+	}
+	distribution, err := GetScalarDistribution(md.Info.ScalarDenomination, market.Bids, market.Asks, md.PriceHistory, minPrice, maxPrice, time.Now())
+	if err != nil {
+		logrus.WithField("marketAddress", market.Id).WithError(err).Errorf("Failed to derive scalar distribution")
+		return nil
+	}
+	return distribution
+}
+
+func (w *Watcher) getMarketPriceImpactCurves(market *markets.Market, md *MarketData) map[uint64]*markets.PriceImpactCurves {
+	minPrice, err := liquidity.ParseDecimal(md.Info.MinPrice)
+	if err != nil {
+		logrus.WithField("marketAddress", market.Id).WithError(err).Errorf("Failed to parse market min price")
+		return nil
+	}
+	maxPrice, err := liquidity.ParseDecimal(md.Info.MaxPrice)
+	if err != nil {
+		logrus.WithField("marketAddress", market.Id).WithError(err).Errorf("Failed to parse market max price")
+		return nil
+	}
+	return GetPriceImpactCurves(market.Bids, market.Asks, liquidity.MarketData{MinPrice: minPrice, MaxPrice: maxPrice}, w.PriceImpact)
+}
+
+// LatestMarketData returns the data of a market as of the last processed block
+func (w *Watcher) LatestMarketData(marketID string) (*MarketData, bool) {
+	w.latestMu.RLock()
+	defer w.latestMu.RUnlock()
+	if w.latest == nil {
+		return nil, false
+	}
+	md, ok := w.latest.ByMarketID[marketID]
+	return md, ok
+}
+
 func deriveTotalMarketsCapitalization(ms []*markets.Market) *markets.Price {
 	price := &markets.Price{}
 	for _, m := range ms {
@@ -240,7 +462,7 @@ func deriveTotalMarketsCapitalization(ms []*markets.Market) *markets.Price {
 	return price
 }
 
-func (w *Watcher) translateMarketInfoToMarket(md *MarketData, ethusd, btceth float64) (*markets.Market, error) {
+func (w *Watcher) translateMarketInfoToMarket(md *MarketData, ethusd, btceth float64, gasPrice *big.Int) (*markets.Market, error) {
 	if md.Info == nil {
 		return nil, fmt.Errorf("`translateMarketInfoToMarket` required a non nil MarketInfo as an argument")
 	}
@@ -253,13 +475,14 @@ func (w *Watcher) translateMarketInfoToMarket(md *MarketData, ethusd, btceth flo
 		return nil, err
 	}
 
-	bidsByOutcome, err := GetBids(md.Orders)
+	bidLevels, err := GetBidLevels(md.Orders)
 	if err != nil {
 		logrus.WithError(err).
 			WithField("marketInfo", *md.Info).
 			Errorf("Failed to get bids by outcome")
 		return nil, err
 	}
+	bidsByOutcome := getListsLiquidityAtPrice(bidLevels)
 	bestBids := map[uint64]*markets.LiquidityAtPrice{}
 	for outcome, list := range bidsByOutcome {
 		if len(list.LiquidityAtPrice) <= 0 {
@@ -268,13 +491,14 @@ func (w *Watcher) translateMarketInfoToMarket(md *MarketData, ethusd, btceth flo
 		bestBids[outcome] = list.LiquidityAtPrice[0]
 	}
 
-	asksByOutcome, err := GetAsks(md.Orders)
+	askLevels, err := GetAskLevels(md.Orders)
 	if err != nil {
 		logrus.WithError(err).
 			WithField("marketInfo", *md.Info).
 			Errorf("Failed to get asks by outcome")
 		return nil, err
 	}
+	asksByOutcome := getListsLiquidityAtPrice(askLevels)
 	bestAsks := map[uint64]*markets.LiquidityAtPrice{}
 	for outcome, asks := range asksByOutcome {
 		if len(asks.LiquidityAtPrice) <= 0 {
@@ -283,7 +507,7 @@ func (w *Watcher) translateMarketInfoToMarket(md *MarketData, ethusd, btceth flo
 		bestAsks[outcome] = asks.LiquidityAtPrice[0]
 	}
 
-	predictions, err := getPredictions(md.Info, md.Orders, bestBids, bestAsks)
+	predictions, err := getWeightedPredictions(md.Info, bestBids, bestAsks, md.PriceHistory, w.Predictions, time.Now())
 	if err != nil {
 		logrus.WithError(err).
 			WithField("marketInfo", md.Info).
@@ -330,60 +554,166 @@ func (w *Watcher) translateMarketInfoToMarket(md *MarketData, ethusd, btceth flo
 		return nil, err
 	}
 
+	// Exact prices for liquidity calculations
+	exactMinPrice, err := liquidity.ParseDecimal(md.Info.MinPrice)
+	if err != nil {
+		logrus.WithError(err).
+			WithField("marketInfo", *md.Info).
+			Errorf("Failed to parse market min price")
+		return nil, err
+	}
+	exactMaxPrice, err := liquidity.ParseDecimal(md.Info.MaxPrice)
+	if err != nil {
+		logrus.WithError(err).
+			WithField("marketInfo", *md.Info).
+			Errorf("Failed to parse market max price")
+		return nil, err
+	}
+
 	liquidityMetrics := &markets.LiquidityMetrics{
 		RetentionRatioByMillietherTranche: map[uint64]float32{},
+		RetentionRatioByUsdTranche:        map[uint64]float32{},
 	}
 	// Construct the order books for liquidity calculations
-	books := getOutcomeOrderBooks(md.Info, bidsByOutcome, asksByOutcome)
-	for _, tranche := range liquidity.Tranches {
+	books, _ := getOutcomeOrderBooks(md.Info, bidLevels, askLevels)
+	liquidityMarket := liquidity.MarketData{
+		MinPrice: exactMinPrice,
+		MaxPrice: exactMaxPrice,
+	}
+	// Same market with the settlement fee deducted from every fill, net
+	// retention ratios are left out if the fee cannot be read
+	netLiquidityMarket := liquidityMarket
+	fees := w.Liquidity.Fees
+	if fees {
+		settlementFee, err := GetSettlementFee(md.Info)
+		if err != nil {
+			logrus.WithError(err).
+				WithField("marketInfo", *md.Info).
+				Errorf("Failed to get market settlement fee")
+			fees = false
+		} else {
+			netLiquidityMarket.SettlementFee = settlementFee
+			liquidityMetrics.NetRetentionRatioByMillietherTranche = map[uint64]float32{}
+			liquidityMetrics.NetRetentionRatioByUsdTranche = map[uint64]float32{}
+			liquidityMetrics.SettlementFee, _ = settlementFee.Float32()
+		}
+	}
+	if gasPrice != nil {
+		liquidityMetrics.ExecutionCostByMillietherTranche = map[uint64]*markets.ExecutionCost{}
+		liquidityMetrics.ExecutionCostByUsdTranche = map[uint64]*markets.ExecutionCost{}
+	}
+	// Returns the retention ratio and the number of orders taken
+	getRetentionRatio := func(allowance currency.Ether, market liquidity.MarketData) (*big.Rat, uint64) {
 		clones := []liquidity.OutcomeOrderBook{}
 		for _, book := range books {
 			clones = append(clones, book.DeepClone())
 		}
-		// Determine shares per complete set
-		const sellingIncrement = 0.01
-
+		rr := w.LiquidityCalculator.GetLiquidityRetentionRatio(allowance, market, clones)
+		fills := uint64(0)
+		for i, book := range books {
+			fills += liquidity.CountFills(book, clones[i])
+		}
+		return rr, fills
+	}
+	// Tranches are computed concurrently and recorded in order once done
+	type trancheJob struct {
+		Tranche   uint64
+		Allowance currency.Ether
+		Gross     map[uint64]float32
+		Net       map[uint64]float32
+		Execution map[uint64]*markets.ExecutionCost
+	}
+	jobs := []trancheJob{}
+	tranches := w.Liquidity.TranchesFor(marketType)
+	for _, tranche := range tranches.MillietherTranches {
 		// Ensure the allowance is in the correct denomination
-		allowance := tranche.Ether()
+		jobs = append(jobs, trancheJob{tranche, currency.Milliether(tranche).Ether(),
+			liquidityMetrics.RetentionRatioByMillietherTranche,
+			liquidityMetrics.NetRetentionRatioByMillietherTranche,
+			liquidityMetrics.ExecutionCostByMillietherTranche})
+	}
+	if ethusd > 0 {
+		for _, tranche := range tranches.USDTranches {
+			jobs = append(jobs, trancheJob{tranche, usdToEther(tranche, ethusd),
+				liquidityMetrics.RetentionRatioByUsdTranche,
+				liquidityMetrics.NetRetentionRatioByUsdTranche,
+				liquidityMetrics.ExecutionCostByUsdTranche})
+		}
+	}
+	gross := make([]*big.Rat, len(jobs))
+	net := make([]*big.Rat, len(jobs))
+	fills := make([]uint64, len(jobs))
+	w.Workers.Run(len(jobs), func(i int) {
+		gross[i], fills[i] = getRetentionRatio(jobs[i].Allowance, liquidityMarket)
+		if fees {
+			net[i], fills[i] = getRetentionRatio(jobs[i].Allowance, netLiquidityMarket)
+		}
+	})
+	for i, job := range jobs {
+		rr := gross[i]
+		job.Gross[job.Tranche], _ = rr.Float32()
+		if fees {
+			rr = net[i]
+			job.Net[job.Tranche], _ = rr.Float32()
+		}
+		if gasPrice != nil {
+			job.Execution[job.Tranche] = w.Gas.GetExecutionCost(fills[i], gasPrice, job.Allowance, rr, ethusd)
+		}
+	}
+	liquidityMetrics.EntryCostsByOutcome = GetEntryCosts(md.Info.Outcomes, bidLevels, askLevels, liquidityMarket, tranches, ethusd, w.Gas, gasPrice)
 
-		rr := w.LiquidityCalculator.GetLiquidityRetentionRatio(
-			sellingIncrement,
-			allowance,
-			liquidity.MarketData{
-				MinPrice: minPrice,
-				MaxPrice: maxPrice,
-			},
-			clones,
-		)
-		liquidityMetrics.RetentionRatioByMillietherTranche[tranche.Uint64()] = float32(rr)
+	// Trading activity is left out if the price history cannot be read
+	var volumeLastDay, volumeLastWeek *markets.Price
+	var tradingActivityByOutcome map[uint64]*markets.OutcomeTradingActivity
+	trendingScore := 0.0
+	activity, err := GetTradingActivity(md.PriceHistory, minPrice, maxPrice, time.Now())
+	if err != nil {
+		logrus.WithError(err).
+			WithField("marketInfo", *md.Info).
+			Errorf("Failed to derive trading activity from price history")
+	} else {
+		volumeLastDay, volumeLastWeek, tradingActivityByOutcome = translateTradingActivity(activity, ethusd, btceth)
+		trendingScore = activity.TrendingScore
 	}
 
+	ApplyPredictionConfidence(predictions, marketType, bidsByOutcome, asksByOutcome, minPrice, maxPrice, md.Info.LastTradeTime, time.Now())
+	overround := getOverround(marketType, predictions)
+	invalid := GetInvalidProbability(md.Info, bestBids, bestAsks, minPrice, maxPrice)
+	predictions = append(predictions, getInvalidPrediction(md.Info, invalid))
+
 	_, featured := featuredlist[md.Info.Id]
 
 	// Construct market data
 	return &markets.Market{
-		Id:                   md.Info.Id,
-		MarketType:           marketType,
-		Name:                 md.Info.Description,
-		CommentCount:         0,
-		MarketCapitalization: marketCapitalization,
-		EndDate:              md.Info.EndTime,
-		Predictions:          predictions,
-		Author:               md.Info.Author,
-		CreationTime:         md.Info.CreationTime,
-		CreationBlock:        md.Info.CreationBlock,
-		ResolutionSource:     md.Info.ResolutionSource,
-		Tags:                 md.Info.Tags,
-		IsFeatured:           featured,
-		Category:             md.Info.Category,
-		LastTradeTime:        md.Info.LastTradeTime,
-		BestBids:             bestBids,
-		BestAsks:             bestAsks,
-		Volume:               volume,
-		Bids:                 bidsByOutcome,
-		Asks:                 asksByOutcome,
-		LiquidityMetrics:     liquidityMetrics,
-		MarketDataSources:    marketDataSources,
+		Id:                        md.Info.Id,
+		MarketType:                marketType,
+		Name:                      md.Info.Description,
+		CommentCount:              0,
+		MarketCapitalization:      marketCapitalization,
+		EndDate:                   md.Info.EndTime,
+		Predictions:               predictions,
+		Author:                    md.Info.Author,
+		CreationTime:              md.Info.CreationTime,
+		CreationBlock:             md.Info.CreationBlock,
+		ResolutionSource:          md.Info.ResolutionSource,
+		Tags:                      md.Info.Tags,
+		IsFeatured:                featured,
+		Category:                  md.Info.Category,
+		LastTradeTime:             md.Info.LastTradeTime,
+		BestBids:                  bestBids,
+		BestAsks:                  bestAsks,
+		Volume:                    volume,
+		Bids:                      bidsByOutcome,
+		Asks:                      asksByOutcome,
+		LiquidityMetrics:          liquidityMetrics,
+		MarketDataSources:         marketDataSources,
+		VolumeLastDay:             volumeLastDay,
+		VolumeLastWeek:            volumeLastWeek,
+		TradingActivityByOutcome:  tradingActivityByOutcome,
+		TrendingScore:             float32(trendingScore),
+		OrderBookMetricsByOutcome: GetOrderBookMetrics(bidsByOutcome, asksByOutcome, minPrice, maxPrice),
+		Overround:                 overround,
+		InvalidPercent:            float32(invalid * 100),
 	}, nil
 
 }
@@ -428,24 +758,15 @@ func getMarketDataSources(id string) (*markets.MarketDataSources, error) {
 	}, nil
 }
 
-func getOutcomeOrderBooks(info *augur.MarketInfo, bids, asks map[uint64]*markets.ListLiquidityAtPrice) []liquidity.OutcomeOrderBook {
+// getOutcomeOrderBooks returns the order books used in liquidity calculations
+// along with the id of the outcome of each book
+func getOutcomeOrderBooks(info *augur.MarketInfo, bids, asks map[uint64][]*liquidity.Level) ([]liquidity.OutcomeOrderBook, []uint64) {
 	books := []liquidity.OutcomeOrderBook{}
+	outcomeIDs := []uint64{}
 
 	// Helper
-	getBidAskLists := func(outcomeID uint64, bidsByOutcome, asksByOutcome map[uint64]*markets.ListLiquidityAtPrice) ([]*markets.LiquidityAtPrice, []*markets.LiquidityAtPrice) {
-		bidsList, ok := bidsByOutcome[outcomeID]
-		if !ok {
-			bidsList = &markets.ListLiquidityAtPrice{
-				LiquidityAtPrice: []*markets.LiquidityAtPrice{},
-			}
-		}
-		asksList, ok := asksByOutcome[outcomeID]
-		if !ok {
-			asksList = &markets.ListLiquidityAtPrice{
-				LiquidityAtPrice: []*markets.LiquidityAtPrice{},
-			}
-		}
-		return bidsList.LiquidityAtPrice, asksList.LiquidityAtPrice
+	getBidAskLists := func(outcomeID uint64, bidsByOutcome, asksByOutcome map[uint64][]*liquidity.Level) ([]*liquidity.Level, []*liquidity.Level) {
+		return bidsByOutcome[outcomeID], asksByOutcome[outcomeID]
 	}
 
 	// Construct the OutcomeOrderBook based on market type
@@ -454,17 +775,19 @@ func getOutcomeOrderBooks(info *augur.MarketInfo, bids, asks map[uint64]*markets
 		for _, outcome := range info.Outcomes {
 			// Create only the OutcomeOrderBook for the yes & upper outcomes
 			if outcome.Id == 1 {
-				book := liquidity.NewOutcomeOrderBook(getBidAskLists(outcome.Id, bids, asks))
+				book := liquidity.NewExactOutcomeOrderBook(getBidAskLists(outcome.Id, bids, asks))
 				books = append(books, book)
+				outcomeIDs = append(outcomeIDs, outcome.Id)
 			}
 		}
 	default: // "categorical"
 		for _, outcome := range info.Outcomes {
-			book := liquidity.NewOutcomeOrderBook(getBidAskLists(outcome.Id, bids, asks))
+			book := liquidity.NewExactOutcomeOrderBook(getBidAskLists(outcome.Id, bids, asks))
 			books = append(books, book)
+			outcomeIDs = append(outcomeIDs, outcome.Id)
 		}
 	}
-	return books
+	return books, outcomeIDs
 }
 
 func getMarketType(info *augur.MarketInfo) (markets.MarketType, error) {
@@ -586,6 +909,32 @@ func (w *Watcher) getMarketsData(ctx context.Context, marketAddresses []string)
 				marketDataByID[marketAddress].Orders = orders
 			}
 		}
+
+		// Market Price History
+		bulkGetMarketPriceHistoryResponse, err := w.AugurAPI.BulkGetMarketPriceHistory(ctx, &augur.BulkGetMarketPriceHistoryRequest{
+			Requests: func() []*augur.GetMarketPriceHistoryRequest {
+				requests := []*augur.GetMarketPriceHistoryRequest{}
+				for _, address := range addresses {
+					requests = append(requests, &augur.GetMarketPriceHistoryRequest{
+						MarketId: address,
+					})
+				}
+				return requests
+			}(),
+		})
+		// Markets are published without the metrics derived from their
+		// price history if it cannot be queried
+		if err != nil {
+			logrus.WithError(err).Errorf("Call to augur-node `BulkGetMarketPriceHistory` failed")
+			continue
+		}
+		for marketAddress, response := range bulkGetMarketPriceHistoryResponse.ResponsesByMarketId {
+			if _, ok := marketDataByID[marketAddress]; !ok {
+				logrus.WithField("marketAddress", marketAddress).Warn("Received price history for a market without market info")
+				continue
+			}
+			marketDataByID[marketAddress].PriceHistory = response.MarketPriceHistory
+		}
 	}
 
 	// Query exchange rates
@@ -600,12 +949,20 @@ func (w *Watcher) getMarketsData(ctx context.Context, marketAddresses []string)
 		return nil, err
 	}
 
+	// Liquidity metrics leave out gas costs without a gas price
+	gasPrice, err := w.Web3API.SuggestGasPrice(ctx)
+	if err != nil {
+		logrus.WithError(err).Errorf("Failed to get gas price")
+		gasPrice = nil
+	}
+
 	return &MarketsData{
 		ByMarketID: marketDataByID,
 		ExchangeRates: &ExchangeRates{
 			ETHUSD: ethusd,
 			BTCETH: btceth,
 		},
+		GasPrice: gasPrice,
 	}, nil
 }
 
@@ -655,31 +1012,7 @@ func mapMarketInfo(info *augur.MarketInfo) *markets.MarketInfo {
 	}
 
 	// Map non equal types
-	switch info.ReportingState {
-	case augur.ReportingState_PRE_REPORTING:
-		m.ReportingState = markets.ReportingState_PRE_REPORTING
-	case augur.ReportingState_DESIGNATED_REPORTING:
-		m.ReportingState = markets.ReportingState_DESIGNATED_REPORTING
-	case augur.ReportingState_OPEN_REPORTING:
-		m.ReportingState = markets.ReportingState_OPEN_REPORTING
-	case augur.ReportingState_CROWDSOURCING_DISPUTE:
-		m.ReportingState = markets.ReportingState_CROWDSOURCING_DISPUTE
-	case augur.ReportingState_AWAITING_NEXT_WINDOW:
-		m.ReportingState = markets.ReportingState_AWAITING_NEXT_WINDOW
-	case augur.ReportingState_AWAITING_FINALIZATION:
-		m.ReportingState = markets.ReportingState_AWAITING_FINALIZATION
-	case augur.ReportingState_FINALIZED:
-		m.ReportingState = markets.ReportingState_FINALIZED
-	case augur.ReportingState_FORKING:
-		m.ReportingState = markets.ReportingState_FORKING
-	case augur.ReportingState_AWAITING_NO_REPORT_MIGRATION:
-		m.ReportingState = markets.ReportingState_AWAITING_NO_REPORT_MIGRATION
-	case augur.ReportingState_AWAITING_FORK_MIGRATION:
-		m.ReportingState = markets.ReportingState_AWAITING_FORK_MIGRATION
-	default:
-		logrus.WithField("reportingState", info.ReportingState).
-			Warnf("Unable to assign reporting state for market info, unhandled enum from augur proto")
-	}
+	m.ReportingState = mapReportingState(info.ReportingState)
 	if info.Consensus != nil {
 		m.Consensus = &markets.NormalizedPayout{
 			IsInvalid: info.Consensus.IsInvalid,
@@ -701,6 +1034,35 @@ func mapMarketInfo(info *augur.MarketInfo) *markets.MarketInfo {
 	return m
 }
 
+func mapReportingState(state augur.ReportingState) markets.ReportingState {
+	switch state {
+	case augur.ReportingState_PRE_REPORTING:
+		return markets.ReportingState_PRE_REPORTING
+	case augur.ReportingState_DESIGNATED_REPORTING:
+		return markets.ReportingState_DESIGNATED_REPORTING
+	case augur.ReportingState_OPEN_REPORTING:
+		return markets.ReportingState_OPEN_REPORTING
+	case augur.ReportingState_CROWDSOURCING_DISPUTE:
+		return markets.ReportingState_CROWDSOURCING_DISPUTE
+	case augur.ReportingState_AWAITING_NEXT_WINDOW:
+		return markets.ReportingState_AWAITING_NEXT_WINDOW
+	case augur.ReportingState_AWAITING_FINALIZATION:
+		return markets.ReportingState_AWAITING_FINALIZATION
+	case augur.ReportingState_FINALIZED:
+		return markets.ReportingState_FINALIZED
+	case augur.ReportingState_FORKING:
+		return markets.ReportingState_FORKING
+	case augur.ReportingState_AWAITING_NO_REPORT_MIGRATION:
+		return markets.ReportingState_AWAITING_NO_REPORT_MIGRATION
+	case augur.ReportingState_AWAITING_FORK_MIGRATION:
+		return markets.ReportingState_AWAITING_FORK_MIGRATION
+	default:
+		logrus.WithField("reportingState", state).
+			Warnf("Unable to assign reporting state for market info, unhandled enum from augur proto")
+	}
+	return markets.ReportingState_PRE_REPORTING
+}
+
 func getLastTradeTimeFromPriceHistory(priceHistory *augur.MarketPriceHistory) uint64 {
 	mostRecent := uint64(0)
 	for _, timestampedPrices := range priceHistory.TimestampedPriceAmountByOutcome {
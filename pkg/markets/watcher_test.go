@@ -0,0 +1,44 @@
+package markets
+
+import (
+	"testing"
+
+	"github.com/stateshape/augur-analyzer/pkg/markets/liquidity"
+	"github.com/stateshape/augur-analyzer/pkg/proto/augur"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTranslateMarketInfoToMarketWithInvalidFee(t *testing.T) {
+	w := &Watcher{
+		LiquidityCalculator: liquidity.NewCalculator(),
+		Liquidity:           &LiquidityConfig{LiquidityTranches: LiquidityTranches{MillietherTranches: []uint64{1000}}, Fees: true},
+		Workers:             NewWorkerPool(1),
+	}
+	info := &augur.MarketInfo{
+		Id:                "0x0000000000000000000000000000000000000001",
+		MarketType:        "yesNo",
+		MinPrice:          "0",
+		MaxPrice:          "1",
+		OutstandingShares: "0",
+		Volume:            "0",
+		Outcomes: []*augur.OutcomeInfo{
+			{Id: 0, Volume: "0", Price: "0.5"},
+			{Id: 1, Volume: "0", Price: "0.5"},
+		},
+	}
+
+	market, err := w.translateMarketInfoToMarket(&MarketData{Info: info}, 200, 30, nil)
+	assert.Nil(t, err)
+	assert.Contains(t, market.LiquidityMetrics.RetentionRatioByMillietherTranche, uint64(1000))
+	assert.NotNil(t, market.LiquidityMetrics.NetRetentionRatioByMillietherTranche)
+
+	// The market is kept without the metrics net of the fee
+	info.SettlementFee = "1.5"
+	market, err = w.translateMarketInfoToMarket(&MarketData{Info: info}, 200, 30, nil)
+	assert.Nil(t, err)
+	assert.Contains(t, market.LiquidityMetrics.RetentionRatioByMillietherTranche, uint64(1000))
+	assert.Nil(t, market.LiquidityMetrics.NetRetentionRatioByMillietherTranche)
+	assert.Nil(t, market.LiquidityMetrics.NetRetentionRatioByUsdTranche)
+	assert.Equal(t, float32(0), market.LiquidityMetrics.SettlementFee)
+}
@@ -0,0 +1,201 @@
+package markets
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/stateshape/augur-analyzer/pkg/proto/augur"
+	"github.com/stateshape/augur-analyzer/pkg/proto/markets"
+
+	"github.com/sirupsen/logrus"
+)
+
+// PredictionConfig selects how the predictions of markets with volume are
+// derived from their trades
+type PredictionConfig struct {
+	Method markets.PredictionMethod
+	// Trailing window of trades averaged by the VWAP, TWAP and BLEND methods
+	Window time.Duration
+	// Weight of the VWAP in the BLEND method, the order book midpoint
+	// weighs the remainder
+	BlendTradeWeight float64
+}
+
+var DefaultPredictionConfig = PredictionConfig{
+	Method:           markets.PredictionMethod_LAST_TRADE_PRICE,
+	Window:           24 * time.Hour,
+	BlendTradeWeight: 0.5,
+}
+
+// Methods which can be configured to derive predictions from trades, and
+// which are published side by side in market details
+var PredictionVariantMethods = []markets.PredictionMethod{
+	markets.PredictionMethod_LAST_TRADE_PRICE,
+	markets.PredictionMethod_VWAP,
+	markets.PredictionMethod_TWAP,
+	markets.PredictionMethod_BLEND,
+}
+
+// ParsePredictionMethod returns the prediction variant method with the
+// name, ignoring case
+func ParsePredictionMethod(name string) (markets.PredictionMethod, error) {
+	for _, method := range PredictionVariantMethods {
+		if strings.EqualFold(method.String(), name) {
+			return method, nil
+		}
+	}
+	return markets.PredictionMethod_LAST_TRADE_PRICE, fmt.Errorf("Unknown prediction method %q", name)
+}
+
+// GetPredictionVariants derives the predictions of a market with every
+// method of PredictionVariantMethods
+func GetPredictionVariants(info *augur.MarketInfo, bestBids, bestAsks map[uint64]*markets.LiquidityAtPrice, history *augur.MarketPriceHistory, config PredictionConfig, now time.Time) ([]*markets.PredictionVariant, error) {
+	variants := []*markets.PredictionVariant{}
+	for _, method := range PredictionVariantMethods {
+		config.Method = method
+		predictions, err := getWeightedPredictions(info, bestBids, bestAsks, history, config, now)
+		if err != nil {
+			return []*markets.PredictionVariant{}, err
+		}
+		variants = append(variants, &markets.PredictionVariant{
+			Method:      method,
+			Predictions: predictions,
+		})
+	}
+	return variants, nil
+}
+
+// getWeightedPredictions derives the predictions of a market with the
+// configured method. Markets without volume are predicted from the order
+// book whatever the method, as are outcomes without trades.
+func getWeightedPredictions(info *augur.MarketInfo, bestBids, bestAsks map[uint64]*markets.LiquidityAtPrice, history *augur.MarketPriceHistory, config PredictionConfig, now time.Time) ([]*markets.Prediction, error) {
+	if config.Method == markets.PredictionMethod_LAST_TRADE_PRICE || info == nil {
+		return getPredictions(info, nil, bestBids, bestAsks)
+	}
+
+	m, err := convertToMarket(info)
+	if err != nil {
+		logrus.WithField("marketInfo", info).
+			Errorf("Failed to convert `MarketInfo` info a properly typed `Market`")
+		return []*markets.Prediction{}, err
+	}
+	if m.Volume <= 0 {
+		return getPredictions(info, nil, bestBids, bestAsks)
+	}
+	os, err := convertToOutcomes(info.Outcomes)
+	if err != nil {
+		logrus.WithField("outcomes", info.Outcomes).
+			Errorf("Failed to convert `OutcomeInfo` to properly types `Outcome`")
+		return []*markets.Prediction{}, err
+	}
+
+	prices, err := getWeightedPrices(history, bestBids, bestAsks, config, now)
+	if err != nil {
+		return []*markets.Prediction{}, err
+	}
+	for _, o := range os {
+		if price, ok := prices[o.ID]; ok {
+			o.Price = price
+		}
+	}
+
+	predictions := []*markets.Prediction{}
+	switch info.MarketType {
+	case MarketTypeYesNo:
+		predictions = append(predictions, getYesNoPredictions(m, os, bestBids, bestAsks)...)
+	case MarketTypeCategorical:
+		predictions = append(predictions, getCategoricalPredictions(m, os, bestBids, bestAsks)...)
+	case MarketTypeScalar:
+		predictions = append(predictions, getScalarPredictions(m, os, bestBids, bestAsks)...)
+	}
+	for _, prediction := range predictions {
+		if prediction.Method == markets.PredictionMethod_LAST_TRADE_PRICE {
+			prediction.Method = config.Method
+		}
+	}
+	return predictions, nil
+}
+
+// getWeightedPrices averages the trades of every outcome which traded with
+// the configured method
+func getWeightedPrices(history *augur.MarketPriceHistory, bestBids, bestAsks map[uint64]*markets.LiquidityAtPrice, config PredictionConfig, now time.Time) (map[uint64]float64, error) {
+	prices := map[uint64]float64{}
+	if history == nil {
+		return prices, nil
+	}
+	from := now.Add(-config.Window)
+	for outcome, list := range history.TimestampedPriceAmountByOutcome {
+		trades, err := parseTimestampedTrades(list)
+		if err != nil {
+			return map[uint64]float64{}, err
+		}
+		if len(trades) == 0 {
+			continue
+		}
+		switch config.Method {
+		case markets.PredictionMethod_VWAP:
+			prices[outcome] = getVWAP(trades, from, now)
+		case markets.PredictionMethod_TWAP:
+			prices[outcome] = getTWAP(trades, from, now)
+		case markets.PredictionMethod_BLEND:
+			price := getVWAP(trades, from, now)
+			bestBid, bestAsk := bestBids[outcome], bestAsks[outcome]
+			if mid, method := getCategoricalLiquidityPrice(bestBid, bestAsk); method == markets.PredictionMethod_ORDER_BOOK_MIDPOINT {
+				price = config.BlendTradeWeight*price + (1-config.BlendTradeWeight)*mid
+			}
+			prices[outcome] = price
+		}
+	}
+	return prices, nil
+}
+
+// getVWAP returns the volume weighted average price of the trades between
+// `from` and `now`, or the last price traded before `now` if there are none.
+// Trades must be sorted by timestamp.
+func getVWAP(trades []*timestampedTrade, from, now time.Time) float64 {
+	amount, total := 0.0, 0.0
+	for _, trade := range trades {
+		if trade.Timestamp.Before(from) {
+			continue
+		}
+		if trade.Timestamp.After(now) {
+			break
+		}
+		amount += trade.Amount
+		total += trade.Amount * trade.Price
+	}
+	if amount <= 0 {
+		return priceAt(trades, now)
+	}
+	return total / amount
+}
+
+// getTWAP returns the average of the last traded price between `from` and
+// `now`, weighted by how long each price stood. The window starts at the
+// first trade if the outcome first traded after `from`. Trades must be sorted
+// by timestamp.
+func getTWAP(trades []*timestampedTrade, from, now time.Time) float64 {
+	start := from
+	if trades[0].Timestamp.After(start) {
+		start = trades[0].Timestamp
+	}
+	if !now.After(start) {
+		return priceAt(trades, now)
+	}
+
+	price, at := priceAt(trades, start), start
+	total := 0.0
+	for _, trade := range trades {
+		if !trade.Timestamp.After(start) {
+			continue
+		}
+		if trade.Timestamp.After(now) {
+			break
+		}
+		total += price * trade.Timestamp.Sub(at).Seconds()
+		price, at = trade.Price, trade.Timestamp
+	}
+	total += price * now.Sub(at).Seconds()
+	return total / now.Sub(start).Seconds()
+}
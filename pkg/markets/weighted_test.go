@@ -0,0 +1,89 @@
+package markets_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stateshape/augur-analyzer/pkg/markets"
+	"github.com/stateshape/augur-analyzer/pkg/proto/augur"
+	protomarkets "github.com/stateshape/augur-analyzer/pkg/proto/markets"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPredictionVariants(t *testing.T) {
+	now := time.Unix(1534000000, 0)
+	ago := func(d time.Duration) uint64 {
+		return uint64(now.Add(-d).Unix())
+	}
+
+	info := &augur.MarketInfo{
+		MarketType: markets.MarketTypeYesNo,
+		MinPrice:   "0",
+		MaxPrice:   "1",
+		Volume:     "50",
+		Outcomes: []*augur.OutcomeInfo{
+			{Id: 0, Volume: "0", Price: "0"},
+			{Id: 1, Volume: "50", Price: "0.7"},
+		},
+	}
+	history := &augur.MarketPriceHistory{
+		TimestampedPriceAmountByOutcome: map[uint64]*augur.ListTimestampedPriceAmount{
+			1: {
+				TimestampedPriceAmounts: []*augur.TimestampedPriceAmount{
+					{Price: "0.7", Amount: "30", Timestamp: ago(6 * time.Hour)},
+					{Price: "0.4", Amount: "10", Timestamp: ago(12 * time.Hour)},
+					{Price: "0.2", Amount: "10", Timestamp: ago(30 * time.Hour)},
+				},
+			},
+		},
+	}
+	bestBids := map[uint64]*protomarkets.LiquidityAtPrice{1: {Price: 0.5, Amount: 1}}
+	bestAsks := map[uint64]*protomarkets.LiquidityAtPrice{1: {Price: 0.6, Amount: 1}}
+
+	variants, err := markets.GetPredictionVariants(info, bestBids, bestAsks, history, markets.DefaultPredictionConfig, now)
+	assert.Nil(t, err)
+	assert.Len(t, variants, len(markets.PredictionVariantMethods))
+
+	percents := map[protomarkets.PredictionMethod]float32{}
+	for _, variant := range variants {
+		assert.Len(t, variant.Predictions, 1)
+		assert.Equal(t, variant.Method, variant.Predictions[0].Method)
+		percents[variant.Method] = variant.Predictions[0].Percent
+	}
+	assert.InDelta(t, 70, percents[protomarkets.PredictionMethod_LAST_TRADE_PRICE], 1e-4)
+	// The trade before the window is left out
+	assert.InDelta(t, 62.5, percents[protomarkets.PredictionMethod_VWAP], 1e-4)
+	// 0.2 for 12 hours, 0.4 for 6 hours and 0.7 for 6 hours
+	assert.InDelta(t, 37.5, percents[protomarkets.PredictionMethod_TWAP], 1e-4)
+	// Half the VWAP and half the 0.55 midpoint
+	assert.InDelta(t, 58.75, percents[protomarkets.PredictionMethod_BLEND], 1e-4)
+
+	t.Run("Market without volume", func(t *testing.T) {
+		untraded := &augur.MarketInfo{
+			MarketType: markets.MarketTypeYesNo,
+			MinPrice:   "0",
+			MaxPrice:   "1",
+			Volume:     "0",
+			Outcomes: []*augur.OutcomeInfo{
+				{Id: 0, Volume: "0", Price: "0"},
+				{Id: 1, Volume: "0", Price: "0"},
+			},
+		}
+		variants, err := markets.GetPredictionVariants(untraded, bestBids, bestAsks, nil, markets.DefaultPredictionConfig, now)
+		assert.Nil(t, err)
+		for _, variant := range variants {
+			assert.Equal(t, protomarkets.PredictionMethod_ORDER_BOOK_MIDPOINT, variant.Predictions[0].Method)
+			assert.InDelta(t, 55, variant.Predictions[0].Percent, 1e-4)
+		}
+	})
+}
+
+func TestParsePredictionMethod(t *testing.T) {
+	method, err := markets.ParsePredictionMethod("vwap")
+	assert.Nil(t, err)
+	assert.Equal(t, protomarkets.PredictionMethod_VWAP, method)
+
+	_, err = markets.ParsePredictionMethod("BEST_BID")
+	assert.NotNil(t, err)
+}
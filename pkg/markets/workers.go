@@ -0,0 +1,52 @@
+package markets
+
+import (
+	"runtime"
+	"sync"
+)
+
+// WorkerPool bounds the goroutines running CPU bound tasks, such as
+// translating markets and computing their liquidity metrics
+type WorkerPool struct {
+	slots chan struct{}
+}
+
+// NewWorkerPool creates a pool of size workers, GOMAXPROCS when the size is
+// not positive
+func NewWorkerPool(size int) *WorkerPool {
+	if size <= 0 {
+		size = runtime.GOMAXPROCS(0)
+	}
+	return &WorkerPool{
+		slots: make(chan struct{}, size),
+	}
+}
+
+// Size is the number of workers of the pool
+func (p *WorkerPool) Size() int {
+	return cap(p.slots)
+}
+
+// Run calls task for every index from 0 to n and returns once every call has
+// returned. Tasks write their result at their index to keep it in order.
+// Tasks run on a free worker, or on the caller when every worker is busy, so
+// tasks may themselves run tasks on the pool without deadlocking it.
+func (p *WorkerPool) Run(n int, task func(i int)) {
+	wg := sync.WaitGroup{}
+	for i := 0; i < n; i++ {
+		select {
+		case p.slots <- struct{}{}:
+			wg.Add(1)
+			go func(i int) {
+				defer func() {
+					<-p.slots
+					wg.Done()
+				}()
+				task(i)
+			}(i)
+		default:
+			task(i)
+		}
+	}
+	wg.Wait()
+}
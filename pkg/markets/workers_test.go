@@ -0,0 +1,52 @@
+package markets_test
+
+import (
+	"runtime"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stateshape/augur-analyzer/pkg/markets"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkerPool(t *testing.T) {
+	assert.Equal(t, runtime.GOMAXPROCS(0), markets.NewWorkerPool(0).Size())
+	pool := markets.NewWorkerPool(2)
+	assert.Equal(t, 2, pool.Size())
+
+	// Results are in order of the tasks
+	squares := make([]int, 100)
+	pool.Run(len(squares), func(i int) {
+		squares[i] = i * i
+	})
+	for i, square := range squares {
+		assert.Equal(t, i*i, square)
+	}
+
+	// Nested tasks run on the caller when every worker is busy, at most the
+	// workers and the caller run at once
+	running, most := int32(0), int32(0)
+	sums := make([]int, 10)
+	pool.Run(len(sums), func(i int) {
+		parts := make([]int, 10)
+		pool.Run(len(parts), func(j int) {
+			n := atomic.AddInt32(&running, 1)
+			for {
+				m := atomic.LoadInt32(&most)
+				if n <= m || atomic.CompareAndSwapInt32(&most, m, n) {
+					break
+				}
+			}
+			parts[j] = i*10 + j
+			atomic.AddInt32(&running, -1)
+		})
+		for _, part := range parts {
+			sums[i] += part
+		}
+	})
+	for i, sum := range sums {
+		assert.Equal(t, i*100+45, sum)
+	}
+	assert.True(t, atomic.LoadInt32(&most) <= 3)
+}
@@ -16,6 +16,16 @@ const (
 	MarketDetailObjectNameV1Format = "augur/markets/%s"
 
 	MarketsSnapshotObjectNameV1 = "snapshot"
+
+	CategoriesSummaryObjectNameV1 = "categories"
+
+	MarketEventsObjectNameV1 = "events"
+
+	UniverseHistoryObjectNameV1 = "history"
+
+	MarketMakerLeaderboardObjectNameV1 = "makers"
+
+	ArbitrageOpportunitiesObjectNameV1 = "arbitrage"
 )
 
 type Writer struct {
@@ -73,3 +83,88 @@ func (w *Writer) WriteMarketDetail(object string, detail *markets.MarketDetailBy
 		},
 	})
 }
+
+func (w *Writer) WriteCategoriesSummary(summary *markets.CategoriesSummary) error {
+	return w.ObjectUploader.WriteObject(&gcloud.UploadObject{
+		Msg:    summary,
+		Bucket: w.Bucket,
+		Object: CategoriesSummaryObjectNameV1,
+		IsGZIP: true,
+		WriterModifier: func(wrtr *storage.Writer) {
+			wrtr.ContentType = "application/octet-stream"
+			wrtr.CacheControl = "public, max-age=15"
+			wrtr.ContentEncoding = "gzip"
+			wrtr.ACL = []storage.ACLRule{
+				{storage.AllUsers, storage.RoleReader},
+			}
+		},
+	})
+}
+
+func (w *Writer) WriteMarketEvents(events *markets.MarketEvents) error {
+	return w.ObjectUploader.WriteObject(&gcloud.UploadObject{
+		Msg:    events,
+		Bucket: w.Bucket,
+		Object: MarketEventsObjectNameV1,
+		IsGZIP: true,
+		WriterModifier: func(wrtr *storage.Writer) {
+			wrtr.ContentType = "application/octet-stream"
+			wrtr.CacheControl = "public, max-age=15"
+			wrtr.ContentEncoding = "gzip"
+			wrtr.ACL = []storage.ACLRule{
+				{storage.AllUsers, storage.RoleReader},
+			}
+		},
+	})
+}
+
+func (w *Writer) WriteUniverseHistory(history *markets.UniverseHistory) error {
+	return w.ObjectUploader.WriteObject(&gcloud.UploadObject{
+		Msg:    history,
+		Bucket: w.Bucket,
+		Object: UniverseHistoryObjectNameV1,
+		IsGZIP: true,
+		WriterModifier: func(wrtr *storage.Writer) {
+			wrtr.ContentType = "application/octet-stream"
+			wrtr.CacheControl = "public, max-age=15"
+			wrtr.ContentEncoding = "gzip"
+			wrtr.ACL = []storage.ACLRule{
+				{storage.AllUsers, storage.RoleReader},
+			}
+		},
+	})
+}
+
+func (w *Writer) WriteMarketMakerLeaderboard(leaderboard *markets.MarketMakerLeaderboard) error {
+	return w.ObjectUploader.WriteObject(&gcloud.UploadObject{
+		Msg:    leaderboard,
+		Bucket: w.Bucket,
+		Object: MarketMakerLeaderboardObjectNameV1,
+		IsGZIP: true,
+		WriterModifier: func(wrtr *storage.Writer) {
+			wrtr.ContentType = "application/octet-stream"
+			wrtr.CacheControl = "public, max-age=15"
+			wrtr.ContentEncoding = "gzip"
+			wrtr.ACL = []storage.ACLRule{
+				{storage.AllUsers, storage.RoleReader},
+			}
+		},
+	})
+}
+
+func (w *Writer) WriteArbitrageOpportunities(opportunities *markets.ArbitrageOpportunities) error {
+	return w.ObjectUploader.WriteObject(&gcloud.UploadObject{
+		Msg:    opportunities,
+		Bucket: w.Bucket,
+		Object: ArbitrageOpportunitiesObjectNameV1,
+		IsGZIP: true,
+		WriterModifier: func(wrtr *storage.Writer) {
+			wrtr.ContentType = "application/octet-stream"
+			wrtr.CacheControl = "public, max-age=15"
+			wrtr.ContentEncoding = "gzip"
+			wrtr.ACL = []storage.ACLRule{
+				{storage.AllUsers, storage.RoleReader},
+			}
+		},
+	})
+}
@@ -41,7 +41,7 @@ func (x MarketType) String() string {
 	return proto.EnumName(MarketType_name, int32(x))
 }
 func (MarketType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_markets_a7085bb6d3b82a3a, []int{0}
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{0}
 }
 
 type ReportingState int32
@@ -88,7 +88,201 @@ func (x ReportingState) String() string {
 	return proto.EnumName(ReportingState_name, int32(x))
 }
 func (ReportingState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_markets_a7085bb6d3b82a3a, []int{1}
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{1}
+}
+
+type MarketEventType int32
+
+const (
+	MarketEventType_MARKET_CREATED          MarketEventType = 0
+	MarketEventType_REPORTING_STATE_CHANGED MarketEventType = 1
+	MarketEventType_FORKING_STARTED         MarketEventType = 2
+	MarketEventType_PREDICTION_MOVED        MarketEventType = 3
+	MarketEventType_ORDER_BOOK_OPENED       MarketEventType = 4
+	MarketEventType_ORDER_BOOK_EMPTIED      MarketEventType = 5
+	MarketEventType_LIQUIDITY_COLLAPSED     MarketEventType = 6
+)
+
+var MarketEventType_name = map[int32]string{
+	0: "MARKET_CREATED",
+	1: "REPORTING_STATE_CHANGED",
+	2: "FORKING_STARTED",
+	3: "PREDICTION_MOVED",
+	4: "ORDER_BOOK_OPENED",
+	5: "ORDER_BOOK_EMPTIED",
+	6: "LIQUIDITY_COLLAPSED",
+}
+var MarketEventType_value = map[string]int32{
+	"MARKET_CREATED":          0,
+	"REPORTING_STATE_CHANGED": 1,
+	"FORKING_STARTED":         2,
+	"PREDICTION_MOVED":        3,
+	"ORDER_BOOK_OPENED":       4,
+	"ORDER_BOOK_EMPTIED":      5,
+	"LIQUIDITY_COLLAPSED":     6,
+}
+
+func (x MarketEventType) String() string {
+	return proto.EnumName(MarketEventType_name, int32(x))
+}
+func (MarketEventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{2}
+}
+
+type ArbitrageType int32
+
+const (
+	// The best asks of all outcomes sum to less than the complete set price
+	ArbitrageType_ASKS_BELOW_COMPLETE_SET ArbitrageType = 0
+	// The best bids of all outcomes sum to more than the complete set price
+	ArbitrageType_BIDS_ABOVE_COMPLETE_SET ArbitrageType = 1
+	// The best bid of an outcome is at or above its best ask
+	ArbitrageType_CROSSED_BOOK ArbitrageType = 2
+)
+
+var ArbitrageType_name = map[int32]string{
+	0: "ASKS_BELOW_COMPLETE_SET",
+	1: "BIDS_ABOVE_COMPLETE_SET",
+	2: "CROSSED_BOOK",
+}
+var ArbitrageType_value = map[string]int32{
+	"ASKS_BELOW_COMPLETE_SET": 0,
+	"BIDS_ABOVE_COMPLETE_SET": 1,
+	"CROSSED_BOOK":            2,
+}
+
+func (x ArbitrageType) String() string {
+	return proto.EnumName(ArbitrageType_name, int32(x))
+}
+func (ArbitrageType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{3}
+}
+
+type PredictionMethod int32
+
+const (
+	PredictionMethod_LAST_TRADE_PRICE PredictionMethod = 0
+	// Weighted average of the best bid and the best ask
+	PredictionMethod_ORDER_BOOK_MIDPOINT PredictionMethod = 1
+	PredictionMethod_BEST_BID            PredictionMethod = 2
+	PredictionMethod_BEST_ASK            PredictionMethod = 3
+	// The outcome has neither trades nor orders
+	PredictionMethod_NO_PRICE PredictionMethod = 4
+	// Volume weighted average price of the trades within the prediction window
+	PredictionMethod_VWAP PredictionMethod = 5
+	// Time weighted average of the last traded price over the prediction window
+	PredictionMethod_TWAP PredictionMethod = 6
+	// VWAP blended with the order book midpoint
+	PredictionMethod_BLEND PredictionMethod = 7
+	// Invalid resolution estimated from prices and market metadata
+	PredictionMethod_INVALID_ESTIMATE PredictionMethod = 8
+)
+
+var PredictionMethod_name = map[int32]string{
+	0: "LAST_TRADE_PRICE",
+	1: "ORDER_BOOK_MIDPOINT",
+	2: "BEST_BID",
+	3: "BEST_ASK",
+	4: "NO_PRICE",
+	5: "VWAP",
+	6: "TWAP",
+	7: "BLEND",
+	8: "INVALID_ESTIMATE",
+}
+var PredictionMethod_value = map[string]int32{
+	"LAST_TRADE_PRICE":    0,
+	"ORDER_BOOK_MIDPOINT": 1,
+	"BEST_BID":            2,
+	"BEST_ASK":            3,
+	"NO_PRICE":            4,
+	"VWAP":                5,
+	"TWAP":                6,
+	"BLEND":               7,
+	"INVALID_ESTIMATE":    8,
+}
+
+func (x PredictionMethod) String() string {
+	return proto.EnumName(PredictionMethod_name, int32(x))
+}
+func (PredictionMethod) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{4}
+}
+
+// PredictionConfidence grades the width of the uncertainty band of a prediction
+// relative to the price range of the market
+type PredictionConfidence int32
+
+const (
+	PredictionConfidence_CONFIDENCE_LOW    PredictionConfidence = 0
+	PredictionConfidence_CONFIDENCE_MEDIUM PredictionConfidence = 1
+	PredictionConfidence_CONFIDENCE_HIGH   PredictionConfidence = 2
+)
+
+var PredictionConfidence_name = map[int32]string{
+	0: "CONFIDENCE_LOW",
+	1: "CONFIDENCE_MEDIUM",
+	2: "CONFIDENCE_HIGH",
+}
+var PredictionConfidence_value = map[string]int32{
+	"CONFIDENCE_LOW":    0,
+	"CONFIDENCE_MEDIUM": 1,
+	"CONFIDENCE_HIGH":   2,
+}
+
+func (x PredictionConfidence) String() string {
+	return proto.EnumName(PredictionConfidence_name, int32(x))
+}
+func (PredictionConfidence) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{5}
+}
+
+type PriceImpactUnit int32
+
+const (
+	PriceImpactUnit_SHARES PriceImpactUnit = 0
+	PriceImpactUnit_ETHER  PriceImpactUnit = 1
+)
+
+var PriceImpactUnit_name = map[int32]string{
+	0: "SHARES",
+	1: "ETHER",
+}
+var PriceImpactUnit_value = map[string]int32{
+	"SHARES": 0,
+	"ETHER":  1,
+}
+
+func (x PriceImpactUnit) String() string {
+	return proto.EnumName(PriceImpactUnit_name, int32(x))
+}
+func (PriceImpactUnit) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{6}
+}
+
+type LiquidityStrategy int32
+
+const (
+	// Sell the shares of one outcome into its bids and buy back the shares
+	// of every other outcome from its asks
+	LiquidityStrategy_SINGLE_BOOK LiquidityStrategy = 0
+	// Sell the shares of every outcome into its own bids
+	LiquidityStrategy_EACH_BOOK LiquidityStrategy = 1
+)
+
+var LiquidityStrategy_name = map[int32]string{
+	0: "SINGLE_BOOK",
+	1: "EACH_BOOK",
+}
+var LiquidityStrategy_value = map[string]int32{
+	"SINGLE_BOOK": 0,
+	"EACH_BOOK":   1,
+}
+
+func (x LiquidityStrategy) String() string {
+	return proto.EnumName(LiquidityStrategy_name, int32(x))
+}
+func (LiquidityStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{7}
 }
 
 type MarketsSummary struct {
@@ -98,16 +292,18 @@ type MarketsSummary struct {
 	Markets                    []*Market               `protobuf:"bytes,4,rep,name=markets,proto3" json:"markets,omitempty"`
 	GenerationTime             uint64                  `protobuf:"varint,5,opt,name=generation_time,json=generationTime,proto3" json:"generation_time,omitempty"`
 	LiquidityMetricsConfig     *LiquidityMetricsConfig `protobuf:"bytes,6,opt,name=liquidity_metrics_config,json=liquidityMetricsConfig,proto3" json:"liquidity_metrics_config,omitempty"`
-	XXX_NoUnkeyedLiteral       struct{}                `json:"-"`
-	XXX_unrecognized           []byte                  `json:"-"`
-	XXX_sizecache              int32                   `json:"-"`
+	// Ids of markets with a positive trending score, most trending first
+	TrendingMarketIds    []string `protobuf:"bytes,7,rep,name=trending_market_ids,json=trendingMarketIds,proto3" json:"trending_market_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *MarketsSummary) Reset()         { *m = MarketsSummary{} }
 func (m *MarketsSummary) String() string { return proto.CompactTextString(m) }
 func (*MarketsSummary) ProtoMessage()    {}
 func (*MarketsSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_markets_a7085bb6d3b82a3a, []int{0}
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{0}
 }
 func (m *MarketsSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MarketsSummary.Unmarshal(m, b)
@@ -169,8 +365,199 @@ func (m *MarketsSummary) GetLiquidityMetricsConfig() *LiquidityMetricsConfig {
 	return nil
 }
 
+func (m *MarketsSummary) GetTrendingMarketIds() []string {
+	if m != nil {
+		return m.TrendingMarketIds
+	}
+	return nil
+}
+
+// CategoriesSummary aggregates the markets of a MarketsSummary by category
+// and by tag. Both lists are ordered from most to least active.
+type CategoriesSummary struct {
+	Block                uint64                `protobuf:"varint,1,opt,name=block,proto3" json:"block,omitempty"`
+	GenerationTime       uint64                `protobuf:"varint,2,opt,name=generation_time,json=generationTime,proto3" json:"generation_time,omitempty"`
+	Categories           []*CategoryStatistics `protobuf:"bytes,3,rep,name=categories,proto3" json:"categories,omitempty"`
+	Tags                 []*CategoryStatistics `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *CategoriesSummary) Reset()         { *m = CategoriesSummary{} }
+func (m *CategoriesSummary) String() string { return proto.CompactTextString(m) }
+func (*CategoriesSummary) ProtoMessage()    {}
+func (*CategoriesSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{1}
+}
+func (m *CategoriesSummary) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CategoriesSummary.Unmarshal(m, b)
+}
+func (m *CategoriesSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CategoriesSummary.Marshal(b, m, deterministic)
+}
+func (dst *CategoriesSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CategoriesSummary.Merge(dst, src)
+}
+func (m *CategoriesSummary) XXX_Size() int {
+	return xxx_messageInfo_CategoriesSummary.Size(m)
+}
+func (m *CategoriesSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_CategoriesSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CategoriesSummary proto.InternalMessageInfo
+
+func (m *CategoriesSummary) GetBlock() uint64 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
+func (m *CategoriesSummary) GetGenerationTime() uint64 {
+	if m != nil {
+		return m.GenerationTime
+	}
+	return 0
+}
+
+func (m *CategoriesSummary) GetCategories() []*CategoryStatistics {
+	if m != nil {
+		return m.Categories
+	}
+	return nil
+}
+
+func (m *CategoriesSummary) GetTags() []*CategoryStatistics {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type CategoryStatistics struct {
+	Name                                     string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	TotalMarkets                             uint64             `protobuf:"varint,2,opt,name=total_markets,json=totalMarkets,proto3" json:"total_markets,omitempty"`
+	TotalMarketsCapitalization               *Price             `protobuf:"bytes,3,opt,name=total_markets_capitalization,json=totalMarketsCapitalization,proto3" json:"total_markets_capitalization,omitempty"`
+	TotalVolume                              *Price             `protobuf:"bytes,4,opt,name=total_volume,json=totalVolume,proto3" json:"total_volume,omitempty"`
+	TotalVolumeLastDay                       *Price             `protobuf:"bytes,5,opt,name=total_volume_last_day,json=totalVolumeLastDay,proto3" json:"total_volume_last_day,omitempty"`
+	OpenInterest                             *Price             `protobuf:"bytes,6,opt,name=open_interest,json=openInterest,proto3" json:"open_interest,omitempty"`
+	AverageRetentionRatioByMillietherTranche map[uint64]float32 `protobuf:"bytes,7,rep,name=average_retention_ratio_by_milliether_tranche,json=averageRetentionRatioByMillietherTranche,proto3" json:"average_retention_ratio_by_milliether_tranche,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	// Keyed by the name of the ReportingState
+	TotalMarketsByReportingState map[string]uint64 `protobuf:"bytes,8,rep,name=total_markets_by_reporting_state,json=totalMarketsByReportingState,proto3" json:"total_markets_by_reporting_state,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Sum of the trending scores of the markets, used to order by activity
+	TrendingScore        float32  `protobuf:"fixed32,9,opt,name=trending_score,json=trendingScore,proto3" json:"trending_score,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CategoryStatistics) Reset()         { *m = CategoryStatistics{} }
+func (m *CategoryStatistics) String() string { return proto.CompactTextString(m) }
+func (*CategoryStatistics) ProtoMessage()    {}
+func (*CategoryStatistics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{2}
+}
+func (m *CategoryStatistics) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CategoryStatistics.Unmarshal(m, b)
+}
+func (m *CategoryStatistics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CategoryStatistics.Marshal(b, m, deterministic)
+}
+func (dst *CategoryStatistics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CategoryStatistics.Merge(dst, src)
+}
+func (m *CategoryStatistics) XXX_Size() int {
+	return xxx_messageInfo_CategoryStatistics.Size(m)
+}
+func (m *CategoryStatistics) XXX_DiscardUnknown() {
+	xxx_messageInfo_CategoryStatistics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CategoryStatistics proto.InternalMessageInfo
+
+func (m *CategoryStatistics) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CategoryStatistics) GetTotalMarkets() uint64 {
+	if m != nil {
+		return m.TotalMarkets
+	}
+	return 0
+}
+
+func (m *CategoryStatistics) GetTotalMarketsCapitalization() *Price {
+	if m != nil {
+		return m.TotalMarketsCapitalization
+	}
+	return nil
+}
+
+func (m *CategoryStatistics) GetTotalVolume() *Price {
+	if m != nil {
+		return m.TotalVolume
+	}
+	return nil
+}
+
+func (m *CategoryStatistics) GetTotalVolumeLastDay() *Price {
+	if m != nil {
+		return m.TotalVolumeLastDay
+	}
+	return nil
+}
+
+func (m *CategoryStatistics) GetOpenInterest() *Price {
+	if m != nil {
+		return m.OpenInterest
+	}
+	return nil
+}
+
+func (m *CategoryStatistics) GetAverageRetentionRatioByMillietherTranche() map[uint64]float32 {
+	if m != nil {
+		return m.AverageRetentionRatioByMillietherTranche
+	}
+	return nil
+}
+
+func (m *CategoryStatistics) GetTotalMarketsByReportingState() map[string]uint64 {
+	if m != nil {
+		return m.TotalMarketsByReportingState
+	}
+	return nil
+}
+
+func (m *CategoryStatistics) GetTrendingScore() float32 {
+	if m != nil {
+		return m.TrendingScore
+	}
+	return 0
+}
+
 type LiquidityMetricsConfig struct {
-	MillietherTranches   []uint64 `protobuf:"varint,1,rep,packed,name=milliether_tranches,json=millietherTranches,proto3" json:"milliether_tranches,omitempty"`
+	// Tranches of market types without tranches of their own
+	MillietherTranches []uint64 `protobuf:"varint,1,rep,packed,name=milliether_tranches,json=millietherTranches,proto3" json:"milliether_tranches,omitempty"`
+	UsdTranches        []uint64 `protobuf:"varint,2,rep,packed,name=usd_tranches,json=usdTranches,proto3" json:"usd_tranches,omitempty"`
+	// Tranches by market type, e.g. "CATEGORICAL", for market types
+	// configured with tranches of their own
+	TranchesByMarketType map[string]*LiquidityTranches `protobuf:"bytes,3,rep,name=tranches_by_market_type,json=tranchesByMarketType,proto3" json:"tranches_by_market_type,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// ETH/USD rate the tranches were converted at
+	EthUsd float32 `protobuf:"fixed32,5,opt,name=eth_usd,json=ethUsd,proto3" json:"eth_usd,omitempty"`
+	// Every tranche converted to the other denomination
+	UsdByMillietherTranche map[uint64]float32 `protobuf:"bytes,6,rep,name=usd_by_milliether_tranche,json=usdByMillietherTranche,proto3" json:"usd_by_milliether_tranche,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	MillietherByUsdTranche map[uint64]float32 `protobuf:"bytes,7,rep,name=milliether_by_usd_tranche,json=millietherByUsdTranche,proto3" json:"milliether_by_usd_tranche,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	// Net retention ratios are published
+	Fees bool `protobuf:"varint,8,opt,name=fees,proto3" json:"fees,omitempty"`
+	// Gas price of the block, zero when the web3 client did not provide it
+	GasPriceGwei float32 `protobuf:"fixed32,9,opt,name=gas_price_gwei,json=gasPriceGwei,proto3" json:"gas_price_gwei,omitempty"`
+	// Gas estimate of taking an order
+	GasPerFill           uint64   `protobuf:"varint,10,opt,name=gas_per_fill,json=gasPerFill,proto3" json:"gas_per_fill,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -180,7 +567,7 @@ func (m *LiquidityMetricsConfig) Reset()         { *m = LiquidityMetricsConfig{}
 func (m *LiquidityMetricsConfig) String() string { return proto.CompactTextString(m) }
 func (*LiquidityMetricsConfig) ProtoMessage()    {}
 func (*LiquidityMetricsConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_markets_a7085bb6d3b82a3a, []int{1}
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{3}
 }
 func (m *LiquidityMetricsConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LiquidityMetricsConfig.Unmarshal(m, b)
@@ -207,6 +594,108 @@ func (m *LiquidityMetricsConfig) GetMillietherTranches() []uint64 {
 	return nil
 }
 
+func (m *LiquidityMetricsConfig) GetUsdTranches() []uint64 {
+	if m != nil {
+		return m.UsdTranches
+	}
+	return nil
+}
+
+func (m *LiquidityMetricsConfig) GetTranchesByMarketType() map[string]*LiquidityTranches {
+	if m != nil {
+		return m.TranchesByMarketType
+	}
+	return nil
+}
+
+func (m *LiquidityMetricsConfig) GetEthUsd() float32 {
+	if m != nil {
+		return m.EthUsd
+	}
+	return 0
+}
+
+func (m *LiquidityMetricsConfig) GetUsdByMillietherTranche() map[uint64]float32 {
+	if m != nil {
+		return m.UsdByMillietherTranche
+	}
+	return nil
+}
+
+func (m *LiquidityMetricsConfig) GetMillietherByUsdTranche() map[uint64]float32 {
+	if m != nil {
+		return m.MillietherByUsdTranche
+	}
+	return nil
+}
+
+func (m *LiquidityMetricsConfig) GetFees() bool {
+	if m != nil {
+		return m.Fees
+	}
+	return false
+}
+
+func (m *LiquidityMetricsConfig) GetGasPriceGwei() float32 {
+	if m != nil {
+		return m.GasPriceGwei
+	}
+	return 0
+}
+
+func (m *LiquidityMetricsConfig) GetGasPerFill() uint64 {
+	if m != nil {
+		return m.GasPerFill
+	}
+	return 0
+}
+
+type LiquidityTranches struct {
+	MillietherTranches   []uint64 `protobuf:"varint,1,rep,packed,name=milliether_tranches,json=millietherTranches,proto3" json:"milliether_tranches,omitempty"`
+	UsdTranches          []uint64 `protobuf:"varint,2,rep,packed,name=usd_tranches,json=usdTranches,proto3" json:"usd_tranches,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LiquidityTranches) Reset()         { *m = LiquidityTranches{} }
+func (m *LiquidityTranches) String() string { return proto.CompactTextString(m) }
+func (*LiquidityTranches) ProtoMessage()    {}
+func (*LiquidityTranches) Descriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{4}
+}
+func (m *LiquidityTranches) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LiquidityTranches.Unmarshal(m, b)
+}
+func (m *LiquidityTranches) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LiquidityTranches.Marshal(b, m, deterministic)
+}
+func (dst *LiquidityTranches) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityTranches.Merge(dst, src)
+}
+func (m *LiquidityTranches) XXX_Size() int {
+	return xxx_messageInfo_LiquidityTranches.Size(m)
+}
+func (m *LiquidityTranches) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityTranches.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidityTranches proto.InternalMessageInfo
+
+func (m *LiquidityTranches) GetMillietherTranches() []uint64 {
+	if m != nil {
+		return m.MillietherTranches
+	}
+	return nil
+}
+
+func (m *LiquidityTranches) GetUsdTranches() []uint64 {
+	if m != nil {
+		return m.UsdTranches
+	}
+	return nil
+}
+
 type Price struct {
 	Eth                  float32  `protobuf:"fixed32,1,opt,name=eth,proto3" json:"eth,omitempty"`
 	Usd                  float32  `protobuf:"fixed32,2,opt,name=usd,proto3" json:"usd,omitempty"`
@@ -220,7 +709,7 @@ func (m *Price) Reset()         { *m = Price{} }
 func (m *Price) String() string { return proto.CompactTextString(m) }
 func (*Price) ProtoMessage()    {}
 func (*Price) Descriptor() ([]byte, []int) {
-	return fileDescriptor_markets_a7085bb6d3b82a3a, []int{2}
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{5}
 }
 func (m *Price) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Price.Unmarshal(m, b)
@@ -262,39 +751,51 @@ func (m *Price) GetBtc() float32 {
 }
 
 type Market struct {
-	Id                   string                           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	MarketType           MarketType                       `protobuf:"varint,2,opt,name=market_type,json=marketType,proto3,enum=markets.MarketType" json:"market_type,omitempty"`
-	Name                 string                           `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	CommentCount         uint32                           `protobuf:"varint,4,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
-	MarketCapitalization *Price                           `protobuf:"bytes,5,opt,name=market_capitalization,json=marketCapitalization,proto3" json:"market_capitalization,omitempty"`
-	EndDate              uint64                           `protobuf:"varint,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	Predictions          []*Prediction                    `protobuf:"bytes,7,rep,name=predictions,proto3" json:"predictions,omitempty"`
-	Author               string                           `protobuf:"bytes,8,opt,name=author,proto3" json:"author,omitempty"`
-	CreationTime         uint64                           `protobuf:"varint,9,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
-	CreationBlock        uint64                           `protobuf:"varint,10,opt,name=creation_block,json=creationBlock,proto3" json:"creation_block,omitempty"`
-	ResolutionSource     string                           `protobuf:"bytes,11,opt,name=resolution_source,json=resolutionSource,proto3" json:"resolution_source,omitempty"`
-	Details              string                           `protobuf:"bytes,12,opt,name=details,proto3" json:"details,omitempty"` // Deprecated: Do not use.
-	Tags                 []string                         `protobuf:"bytes,13,rep,name=tags,proto3" json:"tags,omitempty"`
-	IsFeatured           bool                             `protobuf:"varint,14,opt,name=is_featured,json=isFeatured,proto3" json:"is_featured,omitempty"`
-	Category             string                           `protobuf:"bytes,15,opt,name=category,proto3" json:"category,omitempty"`
-	LastTradeTime        uint64                           `protobuf:"varint,16,opt,name=last_trade_time,json=lastTradeTime,proto3" json:"last_trade_time,omitempty"`
-	BestBids             map[uint64]*LiquidityAtPrice     `protobuf:"bytes,17,rep,name=best_bids,json=bestBids,proto3" json:"best_bids,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	BestAsks             map[uint64]*LiquidityAtPrice     `protobuf:"bytes,18,rep,name=best_asks,json=bestAsks,proto3" json:"best_asks,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Volume               *Price                           `protobuf:"bytes,19,opt,name=volume,proto3" json:"volume,omitempty"`
-	Bids                 map[uint64]*ListLiquidityAtPrice `protobuf:"bytes,20,rep,name=bids,proto3" json:"bids,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Asks                 map[uint64]*ListLiquidityAtPrice `protobuf:"bytes,21,rep,name=asks,proto3" json:"asks,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	LiquidityMetrics     *LiquidityMetrics                `protobuf:"bytes,22,opt,name=liquidity_metrics,json=liquidityMetrics,proto3" json:"liquidity_metrics,omitempty"`
-	MarketDataSources    *MarketDataSources               `protobuf:"bytes,23,opt,name=market_data_sources,json=marketDataSources,proto3" json:"market_data_sources,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
-	XXX_unrecognized     []byte                           `json:"-"`
-	XXX_sizecache        int32                            `json:"-"`
+	Id                       string                             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MarketType               MarketType                         `protobuf:"varint,2,opt,name=market_type,json=marketType,proto3,enum=markets.MarketType" json:"market_type,omitempty"`
+	Name                     string                             `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CommentCount             uint32                             `protobuf:"varint,4,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
+	MarketCapitalization     *Price                             `protobuf:"bytes,5,opt,name=market_capitalization,json=marketCapitalization,proto3" json:"market_capitalization,omitempty"`
+	EndDate                  uint64                             `protobuf:"varint,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Predictions              []*Prediction                      `protobuf:"bytes,7,rep,name=predictions,proto3" json:"predictions,omitempty"`
+	Author                   string                             `protobuf:"bytes,8,opt,name=author,proto3" json:"author,omitempty"`
+	CreationTime             uint64                             `protobuf:"varint,9,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
+	CreationBlock            uint64                             `protobuf:"varint,10,opt,name=creation_block,json=creationBlock,proto3" json:"creation_block,omitempty"`
+	ResolutionSource         string                             `protobuf:"bytes,11,opt,name=resolution_source,json=resolutionSource,proto3" json:"resolution_source,omitempty"`
+	Details                  string                             `protobuf:"bytes,12,opt,name=details,proto3" json:"details,omitempty"` // Deprecated: Do not use.
+	Tags                     []string                           `protobuf:"bytes,13,rep,name=tags,proto3" json:"tags,omitempty"`
+	IsFeatured               bool                               `protobuf:"varint,14,opt,name=is_featured,json=isFeatured,proto3" json:"is_featured,omitempty"`
+	Category                 string                             `protobuf:"bytes,15,opt,name=category,proto3" json:"category,omitempty"`
+	LastTradeTime            uint64                             `protobuf:"varint,16,opt,name=last_trade_time,json=lastTradeTime,proto3" json:"last_trade_time,omitempty"`
+	BestBids                 map[uint64]*LiquidityAtPrice       `protobuf:"bytes,17,rep,name=best_bids,json=bestBids,proto3" json:"best_bids,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	BestAsks                 map[uint64]*LiquidityAtPrice       `protobuf:"bytes,18,rep,name=best_asks,json=bestAsks,proto3" json:"best_asks,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Volume                   *Price                             `protobuf:"bytes,19,opt,name=volume,proto3" json:"volume,omitempty"`
+	Bids                     map[uint64]*ListLiquidityAtPrice   `protobuf:"bytes,20,rep,name=bids,proto3" json:"bids,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Asks                     map[uint64]*ListLiquidityAtPrice   `protobuf:"bytes,21,rep,name=asks,proto3" json:"asks,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	LiquidityMetrics         *LiquidityMetrics                  `protobuf:"bytes,22,opt,name=liquidity_metrics,json=liquidityMetrics,proto3" json:"liquidity_metrics,omitempty"`
+	MarketDataSources        *MarketDataSources                 `protobuf:"bytes,23,opt,name=market_data_sources,json=marketDataSources,proto3" json:"market_data_sources,omitempty"`
+	VolumeLastDay            *Price                             `protobuf:"bytes,24,opt,name=volume_last_day,json=volumeLastDay,proto3" json:"volume_last_day,omitempty"`
+	VolumeLastWeek           *Price                             `protobuf:"bytes,25,opt,name=volume_last_week,json=volumeLastWeek,proto3" json:"volume_last_week,omitempty"`
+	TradingActivityByOutcome map[uint64]*OutcomeTradingActivity `protobuf:"bytes,26,rep,name=trading_activity_by_outcome,json=tradingActivityByOutcome,proto3" json:"trading_activity_by_outcome,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Recency weighted volume scaled up by recent price movement
+	TrendingScore             float32                             `protobuf:"fixed32,27,opt,name=trending_score,json=trendingScore,proto3" json:"trending_score,omitempty"`
+	OrderBookMetricsByOutcome map[uint64]*OutcomeOrderBookMetrics `protobuf:"bytes,28,rep,name=order_book_metrics_by_outcome,json=orderBookMetricsByOutcome,proto3" json:"order_book_metrics_by_outcome,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Categorical markets only: the sum of the implied probabilities of the
+	// outcomes minus 1, e.g. 0.05 when the percents sum to 105
+	Overround float32 `protobuf:"fixed32,29,opt,name=overround,proto3" json:"overround,omitempty"`
+	// Estimated probability, in percent, that the market resolves invalid. The
+	// estimate is also the last prediction of the market.
+	InvalidPercent       float32  `protobuf:"fixed32,30,opt,name=invalid_percent,json=invalidPercent,proto3" json:"invalid_percent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Market) Reset()         { *m = Market{} }
 func (m *Market) String() string { return proto.CompactTextString(m) }
 func (*Market) ProtoMessage()    {}
 func (*Market) Descriptor() ([]byte, []int) {
-	return fileDescriptor_markets_a7085bb6d3b82a3a, []int{3}
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{6}
 }
 func (m *Market) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Market.Unmarshal(m, b)
@@ -476,366 +977,2320 @@ func (m *Market) GetMarketDataSources() *MarketDataSources {
 	return nil
 }
 
-type MarketDataSources struct {
-	MarketDetailFileName string   `protobuf:"bytes,1,opt,name=market_detail_file_name,json=marketDetailFileName,proto3" json:"market_detail_file_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *Market) GetVolumeLastDay() *Price {
+	if m != nil {
+		return m.VolumeLastDay
+	}
+	return nil
 }
 
-func (m *MarketDataSources) Reset()         { *m = MarketDataSources{} }
-func (m *MarketDataSources) String() string { return proto.CompactTextString(m) }
-func (*MarketDataSources) ProtoMessage()    {}
-func (*MarketDataSources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_markets_a7085bb6d3b82a3a, []int{4}
+func (m *Market) GetVolumeLastWeek() *Price {
+	if m != nil {
+		return m.VolumeLastWeek
+	}
+	return nil
+}
+
+func (m *Market) GetTradingActivityByOutcome() map[uint64]*OutcomeTradingActivity {
+	if m != nil {
+		return m.TradingActivityByOutcome
+	}
+	return nil
+}
+
+func (m *Market) GetTrendingScore() float32 {
+	if m != nil {
+		return m.TrendingScore
+	}
+	return 0
+}
+
+func (m *Market) GetOrderBookMetricsByOutcome() map[uint64]*OutcomeOrderBookMetrics {
+	if m != nil {
+		return m.OrderBookMetricsByOutcome
+	}
+	return nil
+}
+
+func (m *Market) GetOverround() float32 {
+	if m != nil {
+		return m.Overround
+	}
+	return 0
+}
+
+func (m *Market) GetInvalidPercent() float32 {
+	if m != nil {
+		return m.InvalidPercent
+	}
+	return 0
+}
+
+// OutcomeOrderBookMetrics summarizes the order book of a single outcome.
+// Relative values are fractions of the price range of the market so they
+// compare across yes/no, categorical and scalar markets.
+type OutcomeOrderBookMetrics struct {
+	// Whether the outcome has both bids and asks. Mid price and spreads are
+	// only set for two sided books.
+	TwoSided       bool    `protobuf:"varint,1,opt,name=two_sided,json=twoSided,proto3" json:"two_sided,omitempty"`
+	MidPrice       float32 `protobuf:"fixed32,2,opt,name=mid_price,json=midPrice,proto3" json:"mid_price,omitempty"`
+	Spread         float32 `protobuf:"fixed32,3,opt,name=spread,proto3" json:"spread,omitempty"`
+	RelativeSpread float32 `protobuf:"fixed32,4,opt,name=relative_spread,json=relativeSpread,proto3" json:"relative_spread,omitempty"`
+	// Keyed by the distance from the mid price in percent of the price range.
+	// One sided books are measured from their best price.
+	DepthByPercentFromMid map[uint32]*OrderBookDepth `protobuf:"bytes,5,rep,name=depth_by_percent_from_mid,json=depthByPercentFromMid,proto3" json:"depth_by_percent_from_mid,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral  struct{}                   `json:"-"`
+	XXX_unrecognized      []byte                     `json:"-"`
+	XXX_sizecache         int32                      `json:"-"`
+}
+
+func (m *OutcomeOrderBookMetrics) Reset()         { *m = OutcomeOrderBookMetrics{} }
+func (m *OutcomeOrderBookMetrics) String() string { return proto.CompactTextString(m) }
+func (*OutcomeOrderBookMetrics) ProtoMessage()    {}
+func (*OutcomeOrderBookMetrics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{7}
+}
+func (m *OutcomeOrderBookMetrics) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OutcomeOrderBookMetrics.Unmarshal(m, b)
+}
+func (m *OutcomeOrderBookMetrics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OutcomeOrderBookMetrics.Marshal(b, m, deterministic)
+}
+func (dst *OutcomeOrderBookMetrics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutcomeOrderBookMetrics.Merge(dst, src)
+}
+func (m *OutcomeOrderBookMetrics) XXX_Size() int {
+	return xxx_messageInfo_OutcomeOrderBookMetrics.Size(m)
+}
+func (m *OutcomeOrderBookMetrics) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutcomeOrderBookMetrics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutcomeOrderBookMetrics proto.InternalMessageInfo
+
+func (m *OutcomeOrderBookMetrics) GetTwoSided() bool {
+	if m != nil {
+		return m.TwoSided
+	}
+	return false
+}
+
+func (m *OutcomeOrderBookMetrics) GetMidPrice() float32 {
+	if m != nil {
+		return m.MidPrice
+	}
+	return 0
+}
+
+func (m *OutcomeOrderBookMetrics) GetSpread() float32 {
+	if m != nil {
+		return m.Spread
+	}
+	return 0
+}
+
+func (m *OutcomeOrderBookMetrics) GetRelativeSpread() float32 {
+	if m != nil {
+		return m.RelativeSpread
+	}
+	return 0
+}
+
+func (m *OutcomeOrderBookMetrics) GetDepthByPercentFromMid() map[uint32]*OrderBookDepth {
+	if m != nil {
+		return m.DepthByPercentFromMid
+	}
+	return nil
+}
+
+// OrderBookDepth is the cumulative liquidity of a range of price levels.
+// Values are the ETH needed to buy the shares, i.e. shares times the
+// distance of their price from the minimum price of the market.
+type OrderBookDepth struct {
+	BidShares            float32  `protobuf:"fixed32,1,opt,name=bid_shares,json=bidShares,proto3" json:"bid_shares,omitempty"`
+	AskShares            float32  `protobuf:"fixed32,2,opt,name=ask_shares,json=askShares,proto3" json:"ask_shares,omitempty"`
+	BidValue             float32  `protobuf:"fixed32,3,opt,name=bid_value,json=bidValue,proto3" json:"bid_value,omitempty"`
+	AskValue             float32  `protobuf:"fixed32,4,opt,name=ask_value,json=askValue,proto3" json:"ask_value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrderBookDepth) Reset()         { *m = OrderBookDepth{} }
+func (m *OrderBookDepth) String() string { return proto.CompactTextString(m) }
+func (*OrderBookDepth) ProtoMessage()    {}
+func (*OrderBookDepth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{8}
+}
+func (m *OrderBookDepth) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderBookDepth.Unmarshal(m, b)
+}
+func (m *OrderBookDepth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderBookDepth.Marshal(b, m, deterministic)
+}
+func (dst *OrderBookDepth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderBookDepth.Merge(dst, src)
+}
+func (m *OrderBookDepth) XXX_Size() int {
+	return xxx_messageInfo_OrderBookDepth.Size(m)
+}
+func (m *OrderBookDepth) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderBookDepth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderBookDepth proto.InternalMessageInfo
+
+func (m *OrderBookDepth) GetBidShares() float32 {
+	if m != nil {
+		return m.BidShares
+	}
+	return 0
+}
+
+func (m *OrderBookDepth) GetAskShares() float32 {
+	if m != nil {
+		return m.AskShares
+	}
+	return 0
+}
+
+func (m *OrderBookDepth) GetBidValue() float32 {
+	if m != nil {
+		return m.BidValue
+	}
+	return 0
+}
+
+func (m *OrderBookDepth) GetAskValue() float32 {
+	if m != nil {
+		return m.AskValue
+	}
+	return 0
+}
+
+// OutcomeTradingActivity summarizes the recent trades of a single outcome.
+// Price changes are the difference between the last traded price and the
+// last price traded before the start of the window.
+type OutcomeTradingActivity struct {
+	VolumeLastDay        *Price   `protobuf:"bytes,1,opt,name=volume_last_day,json=volumeLastDay,proto3" json:"volume_last_day,omitempty"`
+	VolumeLastWeek       *Price   `protobuf:"bytes,2,opt,name=volume_last_week,json=volumeLastWeek,proto3" json:"volume_last_week,omitempty"`
+	LastPrice            float32  `protobuf:"fixed32,3,opt,name=last_price,json=lastPrice,proto3" json:"last_price,omitempty"`
+	PriceChangeLastDay   float32  `protobuf:"fixed32,4,opt,name=price_change_last_day,json=priceChangeLastDay,proto3" json:"price_change_last_day,omitempty"`
+	PriceChangeLastWeek  float32  `protobuf:"fixed32,5,opt,name=price_change_last_week,json=priceChangeLastWeek,proto3" json:"price_change_last_week,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OutcomeTradingActivity) Reset()         { *m = OutcomeTradingActivity{} }
+func (m *OutcomeTradingActivity) String() string { return proto.CompactTextString(m) }
+func (*OutcomeTradingActivity) ProtoMessage()    {}
+func (*OutcomeTradingActivity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{9}
+}
+func (m *OutcomeTradingActivity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OutcomeTradingActivity.Unmarshal(m, b)
+}
+func (m *OutcomeTradingActivity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OutcomeTradingActivity.Marshal(b, m, deterministic)
+}
+func (dst *OutcomeTradingActivity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutcomeTradingActivity.Merge(dst, src)
+}
+func (m *OutcomeTradingActivity) XXX_Size() int {
+	return xxx_messageInfo_OutcomeTradingActivity.Size(m)
+}
+func (m *OutcomeTradingActivity) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutcomeTradingActivity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutcomeTradingActivity proto.InternalMessageInfo
+
+func (m *OutcomeTradingActivity) GetVolumeLastDay() *Price {
+	if m != nil {
+		return m.VolumeLastDay
+	}
+	return nil
+}
+
+func (m *OutcomeTradingActivity) GetVolumeLastWeek() *Price {
+	if m != nil {
+		return m.VolumeLastWeek
+	}
+	return nil
+}
+
+func (m *OutcomeTradingActivity) GetLastPrice() float32 {
+	if m != nil {
+		return m.LastPrice
+	}
+	return 0
+}
+
+func (m *OutcomeTradingActivity) GetPriceChangeLastDay() float32 {
+	if m != nil {
+		return m.PriceChangeLastDay
+	}
+	return 0
+}
+
+func (m *OutcomeTradingActivity) GetPriceChangeLastWeek() float32 {
+	if m != nil {
+		return m.PriceChangeLastWeek
+	}
+	return 0
+}
+
+type MarketDataSources struct {
+	MarketDetailFileName string   `protobuf:"bytes,1,opt,name=market_detail_file_name,json=marketDetailFileName,proto3" json:"market_detail_file_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MarketDataSources) Reset()         { *m = MarketDataSources{} }
+func (m *MarketDataSources) String() string { return proto.CompactTextString(m) }
+func (*MarketDataSources) ProtoMessage()    {}
+func (*MarketDataSources) Descriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{10}
+}
+func (m *MarketDataSources) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MarketDataSources.Unmarshal(m, b)
+}
+func (m *MarketDataSources) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MarketDataSources.Marshal(b, m, deterministic)
+}
+func (dst *MarketDataSources) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketDataSources.Merge(dst, src)
+}
+func (m *MarketDataSources) XXX_Size() int {
+	return xxx_messageInfo_MarketDataSources.Size(m)
+}
+func (m *MarketDataSources) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketDataSources.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketDataSources proto.InternalMessageInfo
+
+func (m *MarketDataSources) GetMarketDetailFileName() string {
+	if m != nil {
+		return m.MarketDetailFileName
+	}
+	return ""
+}
+
+type MarketDetailByMarketId struct {
+	MarketDetailByMarketId map[string]*MarketDetail `protobuf:"bytes,1,rep,name=market_detail_by_market_id,json=marketDetailByMarketId,proto3" json:"market_detail_by_market_id,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral   struct{}                 `json:"-"`
+	XXX_unrecognized       []byte                   `json:"-"`
+	XXX_sizecache          int32                    `json:"-"`
+}
+
+func (m *MarketDetailByMarketId) Reset()         { *m = MarketDetailByMarketId{} }
+func (m *MarketDetailByMarketId) String() string { return proto.CompactTextString(m) }
+func (*MarketDetailByMarketId) ProtoMessage()    {}
+func (*MarketDetailByMarketId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{11}
+}
+func (m *MarketDetailByMarketId) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MarketDetailByMarketId.Unmarshal(m, b)
+}
+func (m *MarketDetailByMarketId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MarketDetailByMarketId.Marshal(b, m, deterministic)
+}
+func (dst *MarketDetailByMarketId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketDetailByMarketId.Merge(dst, src)
+}
+func (m *MarketDetailByMarketId) XXX_Size() int {
+	return xxx_messageInfo_MarketDetailByMarketId.Size(m)
+}
+func (m *MarketDetailByMarketId) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketDetailByMarketId.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketDetailByMarketId proto.InternalMessageInfo
+
+func (m *MarketDetailByMarketId) GetMarketDetailByMarketId() map[string]*MarketDetail {
+	if m != nil {
+		return m.MarketDetailByMarketId
+	}
+	return nil
+}
+
+type MarketDetail struct {
+	MarketId           string              `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	MarketSummary      *Market             `protobuf:"bytes,2,opt,name=market_summary,json=marketSummary,proto3" json:"market_summary,omitempty"`
+	MarketInfo         *MarketInfo         `protobuf:"bytes,3,opt,name=market_info,json=marketInfo,proto3" json:"market_info,omitempty"`
+	OrderBookAnalytics *OrderBookAnalytics `protobuf:"bytes,4,opt,name=order_book_analytics,json=orderBookAnalytics,proto3" json:"order_book_analytics,omitempty"`
+	// Predictions of the market derived with every prediction method
+	PredictionVariants []*PredictionVariant `protobuf:"bytes,5,rep,name=prediction_variants,json=predictionVariants,proto3" json:"prediction_variants,omitempty"`
+	// Only set for scalar markets
+	ScalarDistribution   *ScalarDistribution           `protobuf:"bytes,6,opt,name=scalar_distribution,json=scalarDistribution,proto3" json:"scalar_distribution,omitempty"`
+	PriceImpactByOutcome map[uint64]*PriceImpactCurves `protobuf:"bytes,7,rep,name=price_impact_by_outcome,json=priceImpactByOutcome,proto3" json:"price_impact_by_outcome,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *MarketDetail) Reset()         { *m = MarketDetail{} }
+func (m *MarketDetail) String() string { return proto.CompactTextString(m) }
+func (*MarketDetail) ProtoMessage()    {}
+func (*MarketDetail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{12}
+}
+func (m *MarketDetail) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MarketDetail.Unmarshal(m, b)
+}
+func (m *MarketDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MarketDetail.Marshal(b, m, deterministic)
+}
+func (dst *MarketDetail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketDetail.Merge(dst, src)
+}
+func (m *MarketDetail) XXX_Size() int {
+	return xxx_messageInfo_MarketDetail.Size(m)
+}
+func (m *MarketDetail) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketDetail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketDetail proto.InternalMessageInfo
+
+func (m *MarketDetail) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
+	}
+	return ""
+}
+
+func (m *MarketDetail) GetMarketSummary() *Market {
+	if m != nil {
+		return m.MarketSummary
+	}
+	return nil
+}
+
+func (m *MarketDetail) GetMarketInfo() *MarketInfo {
+	if m != nil {
+		return m.MarketInfo
+	}
+	return nil
+}
+
+func (m *MarketDetail) GetOrderBookAnalytics() *OrderBookAnalytics {
+	if m != nil {
+		return m.OrderBookAnalytics
+	}
+	return nil
+}
+
+func (m *MarketDetail) GetPredictionVariants() []*PredictionVariant {
+	if m != nil {
+		return m.PredictionVariants
+	}
+	return nil
+}
+
+func (m *MarketDetail) GetScalarDistribution() *ScalarDistribution {
+	if m != nil {
+		return m.ScalarDistribution
+	}
+	return nil
+}
+
+func (m *MarketDetail) GetPriceImpactByOutcome() map[uint64]*PriceImpactCurves {
+	if m != nil {
+		return m.PriceImpactByOutcome
+	}
+	return nil
+}
+
+// PriceImpactCurves describe the market orders of increasing size on an
+// outcome, with points of the share ladder followed by points of the ETH
+// ladder
+type PriceImpactCurves struct {
+	// Taking the asks
+	Buy []*PriceImpactPoint `protobuf:"bytes,1,rep,name=buy,proto3" json:"buy,omitempty"`
+	// Taking the bids
+	Sell                 []*PriceImpactPoint `protobuf:"bytes,2,rep,name=sell,proto3" json:"sell,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *PriceImpactCurves) Reset()         { *m = PriceImpactCurves{} }
+func (m *PriceImpactCurves) String() string { return proto.CompactTextString(m) }
+func (*PriceImpactCurves) ProtoMessage()    {}
+func (*PriceImpactCurves) Descriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{13}
+}
+func (m *PriceImpactCurves) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PriceImpactCurves.Unmarshal(m, b)
+}
+func (m *PriceImpactCurves) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PriceImpactCurves.Marshal(b, m, deterministic)
+}
+func (dst *PriceImpactCurves) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceImpactCurves.Merge(dst, src)
+}
+func (m *PriceImpactCurves) XXX_Size() int {
+	return xxx_messageInfo_PriceImpactCurves.Size(m)
+}
+func (m *PriceImpactCurves) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceImpactCurves.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceImpactCurves proto.InternalMessageInfo
+
+func (m *PriceImpactCurves) GetBuy() []*PriceImpactPoint {
+	if m != nil {
+		return m.Buy
+	}
+	return nil
+}
+
+func (m *PriceImpactCurves) GetSell() []*PriceImpactPoint {
+	if m != nil {
+		return m.Sell
+	}
+	return nil
+}
+
+type PriceImpactPoint struct {
+	// Shares traded, or ETH spent for points of the ETH ladder
+	Size float32         `protobuf:"fixed32,1,opt,name=size,proto3" json:"size,omitempty"`
+	Unit PriceImpactUnit `protobuf:"varint,2,opt,name=unit,proto3,enum=markets.PriceImpactUnit" json:"unit,omitempty"`
+	// Shares the order book filled
+	Shares       float32 `protobuf:"fixed32,3,opt,name=shares,proto3" json:"shares,omitempty"`
+	AveragePrice float32 `protobuf:"fixed32,4,opt,name=average_price,json=averagePrice,proto3" json:"average_price,omitempty"`
+	// Best price left in the order book after the trade
+	MarginalPrice float32 `protobuf:"fixed32,5,opt,name=marginal_price,json=marginalPrice,proto3" json:"marginal_price,omitempty"`
+	// The trade took the whole side of the order book, the marginal price
+	// is unset
+	Exhausted            bool     `protobuf:"varint,6,opt,name=exhausted,proto3" json:"exhausted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PriceImpactPoint) Reset()         { *m = PriceImpactPoint{} }
+func (m *PriceImpactPoint) String() string { return proto.CompactTextString(m) }
+func (*PriceImpactPoint) ProtoMessage()    {}
+func (*PriceImpactPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{14}
+}
+func (m *PriceImpactPoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PriceImpactPoint.Unmarshal(m, b)
+}
+func (m *PriceImpactPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PriceImpactPoint.Marshal(b, m, deterministic)
+}
+func (dst *PriceImpactPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceImpactPoint.Merge(dst, src)
+}
+func (m *PriceImpactPoint) XXX_Size() int {
+	return xxx_messageInfo_PriceImpactPoint.Size(m)
+}
+func (m *PriceImpactPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceImpactPoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceImpactPoint proto.InternalMessageInfo
+
+func (m *PriceImpactPoint) GetSize() float32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *PriceImpactPoint) GetUnit() PriceImpactUnit {
+	if m != nil {
+		return m.Unit
+	}
+	return PriceImpactUnit_SHARES
+}
+
+func (m *PriceImpactPoint) GetShares() float32 {
+	if m != nil {
+		return m.Shares
+	}
+	return 0
+}
+
+func (m *PriceImpactPoint) GetAveragePrice() float32 {
+	if m != nil {
+		return m.AveragePrice
+	}
+	return 0
+}
+
+func (m *PriceImpactPoint) GetMarginalPrice() float32 {
+	if m != nil {
+		return m.MarginalPrice
+	}
+	return 0
+}
+
+func (m *PriceImpactPoint) GetExhausted() bool {
+	if m != nil {
+		return m.Exhausted
+	}
+	return false
+}
+
+// ScalarDistribution is the distribution of the resolution value of a scalar
+// market, a normal distribution truncated to the range of the market centred
+// on the mid of its best bid and ask, with a spread from the bid ask spread
+// and the dispersion of its recent trade prices
+type ScalarDistribution struct {
+	ScalarDenomination string  `protobuf:"bytes,1,opt,name=scalar_denomination,json=scalarDenomination,proto3" json:"scalar_denomination,omitempty"`
+	MinPrice           float32 `protobuf:"fixed32,2,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	MaxPrice           float32 `protobuf:"fixed32,3,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	// Values keyed by percentile, e.g. 50 for the median
+	ValueByPercentile map[uint32]float32 `protobuf:"bytes,4,rep,name=value_by_percentile,json=valueByPercentile,proto3" json:"value_by_percentile,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	// Equal width bins from the min price to the max price
+	Histogram            []*ScalarDistributionBin `protobuf:"bytes,5,rep,name=histogram,proto3" json:"histogram,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ScalarDistribution) Reset()         { *m = ScalarDistribution{} }
+func (m *ScalarDistribution) String() string { return proto.CompactTextString(m) }
+func (*ScalarDistribution) ProtoMessage()    {}
+func (*ScalarDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{15}
+}
+func (m *ScalarDistribution) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScalarDistribution.Unmarshal(m, b)
+}
+func (m *ScalarDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScalarDistribution.Marshal(b, m, deterministic)
+}
+func (dst *ScalarDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScalarDistribution.Merge(dst, src)
+}
+func (m *ScalarDistribution) XXX_Size() int {
+	return xxx_messageInfo_ScalarDistribution.Size(m)
+}
+func (m *ScalarDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScalarDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScalarDistribution proto.InternalMessageInfo
+
+func (m *ScalarDistribution) GetScalarDenomination() string {
+	if m != nil {
+		return m.ScalarDenomination
+	}
+	return ""
+}
+
+func (m *ScalarDistribution) GetMinPrice() float32 {
+	if m != nil {
+		return m.MinPrice
+	}
+	return 0
+}
+
+func (m *ScalarDistribution) GetMaxPrice() float32 {
+	if m != nil {
+		return m.MaxPrice
+	}
+	return 0
+}
+
+func (m *ScalarDistribution) GetValueByPercentile() map[uint32]float32 {
+	if m != nil {
+		return m.ValueByPercentile
+	}
+	return nil
+}
+
+func (m *ScalarDistribution) GetHistogram() []*ScalarDistributionBin {
+	if m != nil {
+		return m.Histogram
+	}
+	return nil
+}
+
+type ScalarDistributionBin struct {
+	Lower                float32  `protobuf:"fixed32,1,opt,name=lower,proto3" json:"lower,omitempty"`
+	Upper                float32  `protobuf:"fixed32,2,opt,name=upper,proto3" json:"upper,omitempty"`
+	Probability          float32  `protobuf:"fixed32,3,opt,name=probability,proto3" json:"probability,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ScalarDistributionBin) Reset()         { *m = ScalarDistributionBin{} }
+func (m *ScalarDistributionBin) String() string { return proto.CompactTextString(m) }
+func (*ScalarDistributionBin) ProtoMessage()    {}
+func (*ScalarDistributionBin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{16}
+}
+func (m *ScalarDistributionBin) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScalarDistributionBin.Unmarshal(m, b)
+}
+func (m *ScalarDistributionBin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScalarDistributionBin.Marshal(b, m, deterministic)
+}
+func (dst *ScalarDistributionBin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScalarDistributionBin.Merge(dst, src)
+}
+func (m *ScalarDistributionBin) XXX_Size() int {
+	return xxx_messageInfo_ScalarDistributionBin.Size(m)
+}
+func (m *ScalarDistributionBin) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScalarDistributionBin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScalarDistributionBin proto.InternalMessageInfo
+
+func (m *ScalarDistributionBin) GetLower() float32 {
+	if m != nil {
+		return m.Lower
+	}
+	return 0
+}
+
+func (m *ScalarDistributionBin) GetUpper() float32 {
+	if m != nil {
+		return m.Upper
+	}
+	return 0
+}
+
+func (m *ScalarDistributionBin) GetProbability() float32 {
+	if m != nil {
+		return m.Probability
+	}
+	return 0
+}
+
+type PredictionVariant struct {
+	Method               PredictionMethod `protobuf:"varint,1,opt,name=method,proto3,enum=markets.PredictionMethod" json:"method,omitempty"`
+	Predictions          []*Prediction    `protobuf:"bytes,2,rep,name=predictions,proto3" json:"predictions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *PredictionVariant) Reset()         { *m = PredictionVariant{} }
+func (m *PredictionVariant) String() string { return proto.CompactTextString(m) }
+func (*PredictionVariant) ProtoMessage()    {}
+func (*PredictionVariant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{17}
+}
+func (m *PredictionVariant) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PredictionVariant.Unmarshal(m, b)
+}
+func (m *PredictionVariant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PredictionVariant.Marshal(b, m, deterministic)
+}
+func (dst *PredictionVariant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PredictionVariant.Merge(dst, src)
+}
+func (m *PredictionVariant) XXX_Size() int {
+	return xxx_messageInfo_PredictionVariant.Size(m)
+}
+func (m *PredictionVariant) XXX_DiscardUnknown() {
+	xxx_messageInfo_PredictionVariant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PredictionVariant proto.InternalMessageInfo
+
+func (m *PredictionVariant) GetMethod() PredictionMethod {
+	if m != nil {
+		return m.Method
+	}
+	return PredictionMethod_LAST_TRADE_PRICE
+}
+
+func (m *PredictionVariant) GetPredictions() []*Prediction {
+	if m != nil {
+		return m.Predictions
+	}
+	return nil
+}
+
+// OrderBookAnalytics describes who provides the open orders of a market and
+// for how long. Books dominated by a single maker have a top maker share and
+// a maker concentration index close to 1.
+type OrderBookAnalytics struct {
+	Market               *OrderStatistics            `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	ByOutcome            map[uint64]*OrderStatistics `protobuf:"bytes,2,rep,name=by_outcome,json=byOutcome,proto3" json:"by_outcome,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *OrderBookAnalytics) Reset()         { *m = OrderBookAnalytics{} }
+func (m *OrderBookAnalytics) String() string { return proto.CompactTextString(m) }
+func (*OrderBookAnalytics) ProtoMessage()    {}
+func (*OrderBookAnalytics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{18}
+}
+func (m *OrderBookAnalytics) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderBookAnalytics.Unmarshal(m, b)
+}
+func (m *OrderBookAnalytics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderBookAnalytics.Marshal(b, m, deterministic)
+}
+func (dst *OrderBookAnalytics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderBookAnalytics.Merge(dst, src)
+}
+func (m *OrderBookAnalytics) XXX_Size() int {
+	return xxx_messageInfo_OrderBookAnalytics.Size(m)
+}
+func (m *OrderBookAnalytics) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderBookAnalytics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderBookAnalytics proto.InternalMessageInfo
+
+func (m *OrderBookAnalytics) GetMarket() *OrderStatistics {
+	if m != nil {
+		return m.Market
+	}
+	return nil
+}
+
+func (m *OrderBookAnalytics) GetByOutcome() map[uint64]*OrderStatistics {
+	if m != nil {
+		return m.ByOutcome
+	}
+	return nil
+}
+
+type OrderStatistics struct {
+	OpenOrders     uint64 `protobuf:"varint,1,opt,name=open_orders,json=openOrders,proto3" json:"open_orders,omitempty"`
+	DistinctMakers uint64 `protobuf:"varint,2,opt,name=distinct_makers,json=distinctMakers,proto3" json:"distinct_makers,omitempty"`
+	// Share of the open amount held by the maker with the largest open amount
+	TopMakerShare float32 `protobuf:"fixed32,3,opt,name=top_maker_share,json=topMakerShare,proto3" json:"top_maker_share,omitempty"`
+	// Herfindahl-Hirschman index of the open amount by maker, between 0 and 1
+	MakerConcentrationIndex float32 `protobuf:"fixed32,4,opt,name=maker_concentration_index,json=makerConcentrationIndex,proto3" json:"maker_concentration_index,omitempty"`
+	// Age in seconds of the median open order
+	MedianOrderAge uint64 `protobuf:"varint,5,opt,name=median_order_age,json=medianOrderAge,proto3" json:"median_order_age,omitempty"`
+	// Fraction of the original amount of the open orders which was filled
+	FilledFraction float32 `protobuf:"fixed32,6,opt,name=filled_fraction,json=filledFraction,proto3" json:"filled_fraction,omitempty"`
+	// Tokens (ETH) and shares escrowed by the open orders
+	TokensEscrowed       float32  `protobuf:"fixed32,7,opt,name=tokens_escrowed,json=tokensEscrowed,proto3" json:"tokens_escrowed,omitempty"`
+	SharesEscrowed       float32  `protobuf:"fixed32,8,opt,name=shares_escrowed,json=sharesEscrowed,proto3" json:"shares_escrowed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrderStatistics) Reset()         { *m = OrderStatistics{} }
+func (m *OrderStatistics) String() string { return proto.CompactTextString(m) }
+func (*OrderStatistics) ProtoMessage()    {}
+func (*OrderStatistics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{19}
+}
+func (m *OrderStatistics) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderStatistics.Unmarshal(m, b)
+}
+func (m *OrderStatistics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderStatistics.Marshal(b, m, deterministic)
+}
+func (dst *OrderStatistics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderStatistics.Merge(dst, src)
+}
+func (m *OrderStatistics) XXX_Size() int {
+	return xxx_messageInfo_OrderStatistics.Size(m)
+}
+func (m *OrderStatistics) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderStatistics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderStatistics proto.InternalMessageInfo
+
+func (m *OrderStatistics) GetOpenOrders() uint64 {
+	if m != nil {
+		return m.OpenOrders
+	}
+	return 0
+}
+
+func (m *OrderStatistics) GetDistinctMakers() uint64 {
+	if m != nil {
+		return m.DistinctMakers
+	}
+	return 0
+}
+
+func (m *OrderStatistics) GetTopMakerShare() float32 {
+	if m != nil {
+		return m.TopMakerShare
+	}
+	return 0
+}
+
+func (m *OrderStatistics) GetMakerConcentrationIndex() float32 {
+	if m != nil {
+		return m.MakerConcentrationIndex
+	}
+	return 0
+}
+
+func (m *OrderStatistics) GetMedianOrderAge() uint64 {
+	if m != nil {
+		return m.MedianOrderAge
+	}
+	return 0
+}
+
+func (m *OrderStatistics) GetFilledFraction() float32 {
+	if m != nil {
+		return m.FilledFraction
+	}
+	return 0
+}
+
+func (m *OrderStatistics) GetTokensEscrowed() float32 {
+	if m != nil {
+		return m.TokensEscrowed
+	}
+	return 0
+}
+
+func (m *OrderStatistics) GetSharesEscrowed() float32 {
+	if m != nil {
+		return m.SharesEscrowed
+	}
+	return 0
+}
+
+type Prediction struct {
+	Name      string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Percent   float32 `protobuf:"fixed32,2,opt,name=percent,proto3" json:"percent,omitempty"`
+	Value     float32 `protobuf:"fixed32,3,opt,name=value,proto3" json:"value,omitempty"`
+	OutcomeId uint64  `protobuf:"varint,4,opt,name=outcome_id,json=outcomeId,proto3" json:"outcome_id,omitempty"`
+	// How the percent or value was derived from the market
+	Method PredictionMethod `protobuf:"varint,5,opt,name=method,proto3,enum=markets.PredictionMethod" json:"method,omitempty"`
+	// Categorical markets only: the percent with the overround of the market
+	// removed proportionally, and by raising every price to the power which
+	// makes them sum to 100%
+	ProportionalPercent float32 `protobuf:"fixed32,6,opt,name=proportional_percent,json=proportionalPercent,proto3" json:"proportional_percent,omitempty"`
+	PowerPercent        float32 `protobuf:"fixed32,7,opt,name=power_percent,json=powerPercent,proto3" json:"power_percent,omitempty"`
+	// Uncertainty band around the percent, or around the value for scalar
+	// markets, derived from the depth weighted best prices of the order book
+	// and widened by the staleness of the last trade
+	LowerBound float32              `protobuf:"fixed32,8,opt,name=lower_bound,json=lowerBound,proto3" json:"lower_bound,omitempty"`
+	UpperBound float32              `protobuf:"fixed32,9,opt,name=upper_bound,json=upperBound,proto3" json:"upper_bound,omitempty"`
+	Confidence PredictionConfidence `protobuf:"varint,10,opt,name=confidence,proto3,enum=markets.PredictionConfidence" json:"confidence,omitempty"`
+	// The prediction is the probability of an invalid resolution rather than
+	// of an outcome, also published as the invalid percent of the market. Its
+	// outcome id is the number of outcomes of the market and does not name an
+	// outcome, so consumers of outcome predictions skip it.
+	IsInvalid            bool     `protobuf:"varint,11,opt,name=is_invalid,json=isInvalid,proto3" json:"is_invalid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Prediction) Reset()         { *m = Prediction{} }
+func (m *Prediction) String() string { return proto.CompactTextString(m) }
+func (*Prediction) ProtoMessage()    {}
+func (*Prediction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{20}
+}
+func (m *Prediction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Prediction.Unmarshal(m, b)
+}
+func (m *Prediction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Prediction.Marshal(b, m, deterministic)
+}
+func (dst *Prediction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Prediction.Merge(dst, src)
+}
+func (m *Prediction) XXX_Size() int {
+	return xxx_messageInfo_Prediction.Size(m)
+}
+func (m *Prediction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Prediction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Prediction proto.InternalMessageInfo
+
+func (m *Prediction) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Prediction) GetPercent() float32 {
+	if m != nil {
+		return m.Percent
+	}
+	return 0
+}
+
+func (m *Prediction) GetValue() float32 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *Prediction) GetOutcomeId() uint64 {
+	if m != nil {
+		return m.OutcomeId
+	}
+	return 0
+}
+
+func (m *Prediction) GetMethod() PredictionMethod {
+	if m != nil {
+		return m.Method
+	}
+	return PredictionMethod_LAST_TRADE_PRICE
+}
+
+func (m *Prediction) GetProportionalPercent() float32 {
+	if m != nil {
+		return m.ProportionalPercent
+	}
+	return 0
+}
+
+func (m *Prediction) GetPowerPercent() float32 {
+	if m != nil {
+		return m.PowerPercent
+	}
+	return 0
+}
+
+func (m *Prediction) GetLowerBound() float32 {
+	if m != nil {
+		return m.LowerBound
+	}
+	return 0
+}
+
+func (m *Prediction) GetUpperBound() float32 {
+	if m != nil {
+		return m.UpperBound
+	}
+	return 0
+}
+
+func (m *Prediction) GetConfidence() PredictionConfidence {
+	if m != nil {
+		return m.Confidence
+	}
+	return PredictionConfidence_CONFIDENCE_LOW
+}
+
+func (m *Prediction) GetIsInvalid() bool {
+	if m != nil {
+		return m.IsInvalid
+	}
+	return false
+}
+
+type LiquidityMetrics struct {
+	// Retention ratios gross of fees
+	RetentionRatioByMillietherTranche map[uint64]float32 `protobuf:"bytes,1,rep,name=retention_ratio_by_milliether_tranche,json=retentionRatioByMillietherTranche,proto3" json:"retention_ratio_by_milliether_tranche,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	RetentionRatioByUsdTranche        map[uint64]float32 `protobuf:"bytes,2,rep,name=retention_ratio_by_usd_tranche,json=retentionRatioByUsdTranche,proto3" json:"retention_ratio_by_usd_tranche,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	// Cost of entering a position in every outcome by taking the order book
+	EntryCostsByOutcome map[uint64]*OutcomeEntryCosts `protobuf:"bytes,3,rep,name=entry_costs_by_outcome,json=entryCostsByOutcome,proto3" json:"entry_costs_by_outcome,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Retention ratios net of settlement fees, only when fees are modeled
+	NetRetentionRatioByMillietherTranche map[uint64]float32 `protobuf:"bytes,4,rep,name=net_retention_ratio_by_milliether_tranche,json=netRetentionRatioByMillietherTranche,proto3" json:"net_retention_ratio_by_milliether_tranche,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	NetRetentionRatioByUsdTranche        map[uint64]float32 `protobuf:"bytes,5,rep,name=net_retention_ratio_by_usd_tranche,json=netRetentionRatioByUsdTranche,proto3" json:"net_retention_ratio_by_usd_tranche,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	// Fraction of the proceeds of closing a position paid in fees
+	SettlementFee float32 `protobuf:"fixed32,6,opt,name=settlement_fee,json=settlementFee,proto3" json:"settlement_fee,omitempty"`
+	// Gas paid to liquidate every tranche, only when the gas price is known
+	ExecutionCostByMillietherTranche map[uint64]*ExecutionCost `protobuf:"bytes,7,rep,name=execution_cost_by_milliether_tranche,json=executionCostByMillietherTranche,proto3" json:"execution_cost_by_milliether_tranche,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ExecutionCostByUsdTranche        map[uint64]*ExecutionCost `protobuf:"bytes,8,rep,name=execution_cost_by_usd_tranche,json=executionCostByUsdTranche,proto3" json:"execution_cost_by_usd_tranche,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral             struct{}                  `json:"-"`
+	XXX_unrecognized                 []byte                    `json:"-"`
+	XXX_sizecache                    int32                     `json:"-"`
+}
+
+func (m *LiquidityMetrics) Reset()         { *m = LiquidityMetrics{} }
+func (m *LiquidityMetrics) String() string { return proto.CompactTextString(m) }
+func (*LiquidityMetrics) ProtoMessage()    {}
+func (*LiquidityMetrics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{21}
+}
+func (m *LiquidityMetrics) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LiquidityMetrics.Unmarshal(m, b)
+}
+func (m *LiquidityMetrics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LiquidityMetrics.Marshal(b, m, deterministic)
+}
+func (dst *LiquidityMetrics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityMetrics.Merge(dst, src)
+}
+func (m *LiquidityMetrics) XXX_Size() int {
+	return xxx_messageInfo_LiquidityMetrics.Size(m)
+}
+func (m *LiquidityMetrics) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityMetrics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidityMetrics proto.InternalMessageInfo
+
+func (m *LiquidityMetrics) GetRetentionRatioByMillietherTranche() map[uint64]float32 {
+	if m != nil {
+		return m.RetentionRatioByMillietherTranche
+	}
+	return nil
+}
+
+func (m *LiquidityMetrics) GetRetentionRatioByUsdTranche() map[uint64]float32 {
+	if m != nil {
+		return m.RetentionRatioByUsdTranche
+	}
+	return nil
+}
+
+func (m *LiquidityMetrics) GetEntryCostsByOutcome() map[uint64]*OutcomeEntryCosts {
+	if m != nil {
+		return m.EntryCostsByOutcome
+	}
+	return nil
+}
+
+func (m *LiquidityMetrics) GetNetRetentionRatioByMillietherTranche() map[uint64]float32 {
+	if m != nil {
+		return m.NetRetentionRatioByMillietherTranche
+	}
+	return nil
+}
+
+func (m *LiquidityMetrics) GetNetRetentionRatioByUsdTranche() map[uint64]float32 {
+	if m != nil {
+		return m.NetRetentionRatioByUsdTranche
+	}
+	return nil
+}
+
+func (m *LiquidityMetrics) GetSettlementFee() float32 {
+	if m != nil {
+		return m.SettlementFee
+	}
+	return 0
+}
+
+func (m *LiquidityMetrics) GetExecutionCostByMillietherTranche() map[uint64]*ExecutionCost {
+	if m != nil {
+		return m.ExecutionCostByMillietherTranche
+	}
+	return nil
+}
+
+func (m *LiquidityMetrics) GetExecutionCostByUsdTranche() map[uint64]*ExecutionCost {
+	if m != nil {
+		return m.ExecutionCostByUsdTranche
+	}
+	return nil
+}
+
+// ExecutionCost is the gas paid for liquidating a tranche by taking orders
+type ExecutionCost struct {
+	// Orders taken
+	Fills  uint64  `protobuf:"varint,1,opt,name=fills,proto3" json:"fills,omitempty"`
+	GasEth float32 `protobuf:"fixed32,2,opt,name=gas_eth,json=gasEth,proto3" json:"gas_eth,omitempty"`
+	GasUsd float32 `protobuf:"fixed32,3,opt,name=gas_usd,json=gasUsd,proto3" json:"gas_usd,omitempty"`
+	// Retention ratio, net of fees when they are modeled, less the gas paid
+	// as a fraction of the tranche. Never negative.
+	RetentionRatio       float32  `protobuf:"fixed32,4,opt,name=retention_ratio,json=retentionRatio,proto3" json:"retention_ratio,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExecutionCost) Reset()         { *m = ExecutionCost{} }
+func (m *ExecutionCost) String() string { return proto.CompactTextString(m) }
+func (*ExecutionCost) ProtoMessage()    {}
+func (*ExecutionCost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{22}
+}
+func (m *ExecutionCost) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExecutionCost.Unmarshal(m, b)
+}
+func (m *ExecutionCost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExecutionCost.Marshal(b, m, deterministic)
+}
+func (dst *ExecutionCost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionCost.Merge(dst, src)
+}
+func (m *ExecutionCost) XXX_Size() int {
+	return xxx_messageInfo_ExecutionCost.Size(m)
+}
+func (m *ExecutionCost) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionCost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutionCost proto.InternalMessageInfo
+
+func (m *ExecutionCost) GetFills() uint64 {
+	if m != nil {
+		return m.Fills
+	}
+	return 0
+}
+
+func (m *ExecutionCost) GetGasEth() float32 {
+	if m != nil {
+		return m.GasEth
+	}
+	return 0
+}
+
+func (m *ExecutionCost) GetGasUsd() float32 {
+	if m != nil {
+		return m.GasUsd
+	}
+	return 0
+}
+
+func (m *ExecutionCost) GetRetentionRatio() float32 {
+	if m != nil {
+		return m.RetentionRatio
+	}
+	return 0
+}
+
+// OutcomeEntryCosts are the costs of spending every tranche on buying an
+// outcome, by taking its asks, or on shorting it, by taking its bids. Tranches
+// the order book cannot fill at all are left out.
+type OutcomeEntryCosts struct {
+	BuyByMillietherTranche   map[uint64]*EntryCost `protobuf:"bytes,1,rep,name=buy_by_milliether_tranche,json=buyByMillietherTranche,proto3" json:"buy_by_milliether_tranche,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ShortByMillietherTranche map[uint64]*EntryCost `protobuf:"bytes,2,rep,name=short_by_milliether_tranche,json=shortByMillietherTranche,proto3" json:"short_by_milliether_tranche,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	BuyByUsdTranche          map[uint64]*EntryCost `protobuf:"bytes,3,rep,name=buy_by_usd_tranche,json=buyByUsdTranche,proto3" json:"buy_by_usd_tranche,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ShortByUsdTranche        map[uint64]*EntryCost `protobuf:"bytes,4,rep,name=short_by_usd_tranche,json=shortByUsdTranche,proto3" json:"short_by_usd_tranche,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral     struct{}              `json:"-"`
+	XXX_unrecognized         []byte                `json:"-"`
+	XXX_sizecache            int32                 `json:"-"`
+}
+
+func (m *OutcomeEntryCosts) Reset()         { *m = OutcomeEntryCosts{} }
+func (m *OutcomeEntryCosts) String() string { return proto.CompactTextString(m) }
+func (*OutcomeEntryCosts) ProtoMessage()    {}
+func (*OutcomeEntryCosts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{23}
+}
+func (m *OutcomeEntryCosts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OutcomeEntryCosts.Unmarshal(m, b)
+}
+func (m *OutcomeEntryCosts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OutcomeEntryCosts.Marshal(b, m, deterministic)
+}
+func (dst *OutcomeEntryCosts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutcomeEntryCosts.Merge(dst, src)
+}
+func (m *OutcomeEntryCosts) XXX_Size() int {
+	return xxx_messageInfo_OutcomeEntryCosts.Size(m)
+}
+func (m *OutcomeEntryCosts) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutcomeEntryCosts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutcomeEntryCosts proto.InternalMessageInfo
+
+func (m *OutcomeEntryCosts) GetBuyByMillietherTranche() map[uint64]*EntryCost {
+	if m != nil {
+		return m.BuyByMillietherTranche
+	}
+	return nil
+}
+
+func (m *OutcomeEntryCosts) GetShortByMillietherTranche() map[uint64]*EntryCost {
+	if m != nil {
+		return m.ShortByMillietherTranche
+	}
+	return nil
+}
+
+func (m *OutcomeEntryCosts) GetBuyByUsdTranche() map[uint64]*EntryCost {
+	if m != nil {
+		return m.BuyByUsdTranche
+	}
+	return nil
+}
+
+func (m *OutcomeEntryCosts) GetShortByUsdTranche() map[uint64]*EntryCost {
+	if m != nil {
+		return m.ShortByUsdTranche
+	}
+	return nil
+}
+
+type EntryCost struct {
+	Shares       float32 `protobuf:"fixed32,1,opt,name=shares,proto3" json:"shares,omitempty"`
+	AveragePrice float32 `protobuf:"fixed32,2,opt,name=average_price,json=averagePrice,proto3" json:"average_price,omitempty"`
+	// Distance from the best price to the average price, as a fraction of
+	// the price range of the market
+	Slippage float32 `protobuf:"fixed32,3,opt,name=slippage,proto3" json:"slippage,omitempty"`
+	// Fraction of the tranche the order book could absorb
+	Filled float32 `protobuf:"fixed32,4,opt,name=filled,proto3" json:"filled,omitempty"`
+	// Orders taken
+	Fills uint64 `protobuf:"varint,5,opt,name=fills,proto3" json:"fills,omitempty"`
+	// Gas paid for taking the orders, only when the gas price is known
+	GasEth               float32  `protobuf:"fixed32,6,opt,name=gas_eth,json=gasEth,proto3" json:"gas_eth,omitempty"`
+	GasUsd               float32  `protobuf:"fixed32,7,opt,name=gas_usd,json=gasUsd,proto3" json:"gas_usd,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EntryCost) Reset()         { *m = EntryCost{} }
+func (m *EntryCost) String() string { return proto.CompactTextString(m) }
+func (*EntryCost) ProtoMessage()    {}
+func (*EntryCost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{24}
+}
+func (m *EntryCost) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EntryCost.Unmarshal(m, b)
+}
+func (m *EntryCost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EntryCost.Marshal(b, m, deterministic)
+}
+func (dst *EntryCost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntryCost.Merge(dst, src)
+}
+func (m *EntryCost) XXX_Size() int {
+	return xxx_messageInfo_EntryCost.Size(m)
+}
+func (m *EntryCost) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntryCost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EntryCost proto.InternalMessageInfo
+
+func (m *EntryCost) GetShares() float32 {
+	if m != nil {
+		return m.Shares
+	}
+	return 0
+}
+
+func (m *EntryCost) GetAveragePrice() float32 {
+	if m != nil {
+		return m.AveragePrice
+	}
+	return 0
+}
+
+func (m *EntryCost) GetSlippage() float32 {
+	if m != nil {
+		return m.Slippage
+	}
+	return 0
+}
+
+func (m *EntryCost) GetFilled() float32 {
+	if m != nil {
+		return m.Filled
+	}
+	return 0
+}
+
+func (m *EntryCost) GetFills() uint64 {
+	if m != nil {
+		return m.Fills
+	}
+	return 0
+}
+
+func (m *EntryCost) GetGasEth() float32 {
+	if m != nil {
+		return m.GasEth
+	}
+	return 0
+}
+
+func (m *EntryCost) GetGasUsd() float32 {
+	if m != nil {
+		return m.GasUsd
+	}
+	return 0
+}
+
+// LiquidityTrace records every increment of the liquidity retention
+// calculation of a market for a single allowance
+type LiquidityTrace struct {
+	MarketId string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// Allowance in ETH
+	Allowance      float32                    `protobuf:"fixed32,2,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	CompleteSets   float32                    `protobuf:"fixed32,4,opt,name=complete_sets,json=completeSets,proto3" json:"complete_sets,omitempty"`
+	Proceeds       float32                    `protobuf:"fixed32,5,opt,name=proceeds,proto3" json:"proceeds,omitempty"`
+	RetentionRatio float32                    `protobuf:"fixed32,6,opt,name=retention_ratio,json=retentionRatio,proto3" json:"retention_ratio,omitempty"`
+	Increments     []*LiquidityTraceIncrement `protobuf:"bytes,7,rep,name=increments,proto3" json:"increments,omitempty"`
+	// Fee deducted from every fill, zero when fees are not modeled
+	SettlementFee        float32  `protobuf:"fixed32,8,opt,name=settlement_fee,json=settlementFee,proto3" json:"settlement_fee,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LiquidityTrace) Reset()         { *m = LiquidityTrace{} }
+func (m *LiquidityTrace) String() string { return proto.CompactTextString(m) }
+func (*LiquidityTrace) ProtoMessage()    {}
+func (*LiquidityTrace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{25}
+}
+func (m *LiquidityTrace) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LiquidityTrace.Unmarshal(m, b)
+}
+func (m *LiquidityTrace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LiquidityTrace.Marshal(b, m, deterministic)
+}
+func (dst *LiquidityTrace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityTrace.Merge(dst, src)
+}
+func (m *LiquidityTrace) XXX_Size() int {
+	return xxx_messageInfo_LiquidityTrace.Size(m)
+}
+func (m *LiquidityTrace) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityTrace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidityTrace proto.InternalMessageInfo
+
+func (m *LiquidityTrace) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
+	}
+	return ""
+}
+
+func (m *LiquidityTrace) GetAllowance() float32 {
+	if m != nil {
+		return m.Allowance
+	}
+	return 0
+}
+
+func (m *LiquidityTrace) GetCompleteSets() float32 {
+	if m != nil {
+		return m.CompleteSets
+	}
+	return 0
+}
+
+func (m *LiquidityTrace) GetProceeds() float32 {
+	if m != nil {
+		return m.Proceeds
+	}
+	return 0
+}
+
+func (m *LiquidityTrace) GetRetentionRatio() float32 {
+	if m != nil {
+		return m.RetentionRatio
+	}
+	return 0
+}
+
+func (m *LiquidityTrace) GetIncrements() []*LiquidityTraceIncrement {
+	if m != nil {
+		return m.Increments
+	}
+	return nil
+}
+
+func (m *LiquidityTrace) GetSettlementFee() float32 {
+	if m != nil {
+		return m.SettlementFee
+	}
+	return 0
+}
+
+type LiquidityTraceIncrement struct {
+	Shares   float32           `protobuf:"fixed32,1,opt,name=shares,proto3" json:"shares,omitempty"`
+	Strategy LiquidityStrategy `protobuf:"varint,2,opt,name=strategy,proto3,enum=markets.LiquidityStrategy" json:"strategy,omitempty"`
+	// Outcome of the book of the single book strategy
+	OutcomeId uint64                `protobuf:"varint,3,opt,name=outcome_id,json=outcomeId,proto3" json:"outcome_id,omitempty"`
+	Fills     []*LiquidityTraceFill `protobuf:"bytes,6,rep,name=fills,proto3" json:"fills,omitempty"`
+	Proceeds  float32               `protobuf:"fixed32,7,opt,name=proceeds,proto3" json:"proceeds,omitempty"`
+	// Complete sets left to sell after the increment
+	RemainingCompleteSets float32  `protobuf:"fixed32,8,opt,name=remaining_complete_sets,json=remainingCompleteSets,proto3" json:"remaining_complete_sets,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *LiquidityTraceIncrement) Reset()         { *m = LiquidityTraceIncrement{} }
+func (m *LiquidityTraceIncrement) String() string { return proto.CompactTextString(m) }
+func (*LiquidityTraceIncrement) ProtoMessage()    {}
+func (*LiquidityTraceIncrement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{26}
+}
+func (m *LiquidityTraceIncrement) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LiquidityTraceIncrement.Unmarshal(m, b)
+}
+func (m *LiquidityTraceIncrement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LiquidityTraceIncrement.Marshal(b, m, deterministic)
+}
+func (dst *LiquidityTraceIncrement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityTraceIncrement.Merge(dst, src)
+}
+func (m *LiquidityTraceIncrement) XXX_Size() int {
+	return xxx_messageInfo_LiquidityTraceIncrement.Size(m)
+}
+func (m *LiquidityTraceIncrement) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityTraceIncrement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidityTraceIncrement proto.InternalMessageInfo
+
+func (m *LiquidityTraceIncrement) GetShares() float32 {
+	if m != nil {
+		return m.Shares
+	}
+	return 0
+}
+
+func (m *LiquidityTraceIncrement) GetStrategy() LiquidityStrategy {
+	if m != nil {
+		return m.Strategy
+	}
+	return LiquidityStrategy_SINGLE_BOOK
+}
+
+func (m *LiquidityTraceIncrement) GetOutcomeId() uint64 {
+	if m != nil {
+		return m.OutcomeId
+	}
+	return 0
+}
+
+func (m *LiquidityTraceIncrement) GetFills() []*LiquidityTraceFill {
+	if m != nil {
+		return m.Fills
+	}
+	return nil
+}
+
+func (m *LiquidityTraceIncrement) GetProceeds() float32 {
+	if m != nil {
+		return m.Proceeds
+	}
+	return 0
+}
+
+func (m *LiquidityTraceIncrement) GetRemainingCompleteSets() float32 {
+	if m != nil {
+		return m.RemainingCompleteSets
+	}
+	return 0
+}
+
+type LiquidityTraceFill struct {
+	OutcomeId uint64 `protobuf:"varint,1,opt,name=outcome_id,json=outcomeId,proto3" json:"outcome_id,omitempty"`
+	// The shares were bought back from the asks rather than sold into the bids
+	ClosingShort bool    `protobuf:"varint,2,opt,name=closing_short,json=closingShort,proto3" json:"closing_short,omitempty"`
+	Shares       float32 `protobuf:"fixed32,3,opt,name=shares,proto3" json:"shares,omitempty"`
+	Proceeds     float32 `protobuf:"fixed32,4,opt,name=proceeds,proto3" json:"proceeds,omitempty"`
+	// Levels taken, best first, with the amount taken from each
+	Levels               []*LiquidityAtPrice `protobuf:"bytes,5,rep,name=levels,proto3" json:"levels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *LiquidityTraceFill) Reset()         { *m = LiquidityTraceFill{} }
+func (m *LiquidityTraceFill) String() string { return proto.CompactTextString(m) }
+func (*LiquidityTraceFill) ProtoMessage()    {}
+func (*LiquidityTraceFill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{27}
+}
+func (m *LiquidityTraceFill) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LiquidityTraceFill.Unmarshal(m, b)
+}
+func (m *LiquidityTraceFill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LiquidityTraceFill.Marshal(b, m, deterministic)
+}
+func (dst *LiquidityTraceFill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityTraceFill.Merge(dst, src)
+}
+func (m *LiquidityTraceFill) XXX_Size() int {
+	return xxx_messageInfo_LiquidityTraceFill.Size(m)
+}
+func (m *LiquidityTraceFill) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityTraceFill.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidityTraceFill proto.InternalMessageInfo
+
+func (m *LiquidityTraceFill) GetOutcomeId() uint64 {
+	if m != nil {
+		return m.OutcomeId
+	}
+	return 0
+}
+
+func (m *LiquidityTraceFill) GetClosingShort() bool {
+	if m != nil {
+		return m.ClosingShort
+	}
+	return false
+}
+
+func (m *LiquidityTraceFill) GetShares() float32 {
+	if m != nil {
+		return m.Shares
+	}
+	return 0
+}
+
+func (m *LiquidityTraceFill) GetProceeds() float32 {
+	if m != nil {
+		return m.Proceeds
+	}
+	return 0
+}
+
+func (m *LiquidityTraceFill) GetLevels() []*LiquidityAtPrice {
+	if m != nil {
+		return m.Levels
+	}
+	return nil
+}
+
+// LiquidityAtPrice represents a single price point in a market outcome's Order book.
+// Note that one bid LiquidityAtPrice may represent an aggregation of N Orders.
+type LiquidityAtPrice struct {
+	Price                float32  `protobuf:"fixed32,1,opt,name=price,proto3" json:"price,omitempty"`
+	Amount               float32  `protobuf:"fixed32,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LiquidityAtPrice) Reset()         { *m = LiquidityAtPrice{} }
+func (m *LiquidityAtPrice) String() string { return proto.CompactTextString(m) }
+func (*LiquidityAtPrice) ProtoMessage()    {}
+func (*LiquidityAtPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{28}
+}
+func (m *LiquidityAtPrice) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LiquidityAtPrice.Unmarshal(m, b)
+}
+func (m *LiquidityAtPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LiquidityAtPrice.Marshal(b, m, deterministic)
+}
+func (dst *LiquidityAtPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityAtPrice.Merge(dst, src)
+}
+func (m *LiquidityAtPrice) XXX_Size() int {
+	return xxx_messageInfo_LiquidityAtPrice.Size(m)
+}
+func (m *LiquidityAtPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityAtPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidityAtPrice proto.InternalMessageInfo
+
+func (m *LiquidityAtPrice) GetPrice() float32 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *LiquidityAtPrice) GetAmount() float32 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type ListLiquidityAtPrice struct {
+	LiquidityAtPrice     []*LiquidityAtPrice `protobuf:"bytes,1,rep,name=liquidity_at_price,json=liquidityAtPrice,proto3" json:"liquidity_at_price,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ListLiquidityAtPrice) Reset()         { *m = ListLiquidityAtPrice{} }
+func (m *ListLiquidityAtPrice) String() string { return proto.CompactTextString(m) }
+func (*ListLiquidityAtPrice) ProtoMessage()    {}
+func (*ListLiquidityAtPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{29}
+}
+func (m *ListLiquidityAtPrice) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListLiquidityAtPrice.Unmarshal(m, b)
+}
+func (m *ListLiquidityAtPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListLiquidityAtPrice.Marshal(b, m, deterministic)
+}
+func (dst *ListLiquidityAtPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListLiquidityAtPrice.Merge(dst, src)
+}
+func (m *ListLiquidityAtPrice) XXX_Size() int {
+	return xxx_messageInfo_ListLiquidityAtPrice.Size(m)
+}
+func (m *ListLiquidityAtPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListLiquidityAtPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListLiquidityAtPrice proto.InternalMessageInfo
+
+func (m *ListLiquidityAtPrice) GetLiquidityAtPrice() []*LiquidityAtPrice {
+	if m != nil {
+		return m.LiquidityAtPrice
+	}
+	return nil
+}
+
+type MarketsSnapshot struct {
+	MarketsSummary       *MarketsSummary `protobuf:"bytes,1,opt,name=markets_summary,json=marketsSummary,proto3" json:"markets_summary,omitempty"`
+	MarketInfos          []*MarketInfo   `protobuf:"bytes,2,rep,name=market_infos,json=marketInfos,proto3" json:"market_infos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *MarketsSnapshot) Reset()         { *m = MarketsSnapshot{} }
+func (m *MarketsSnapshot) String() string { return proto.CompactTextString(m) }
+func (*MarketsSnapshot) ProtoMessage()    {}
+func (*MarketsSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{30}
+}
+func (m *MarketsSnapshot) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MarketsSnapshot.Unmarshal(m, b)
+}
+func (m *MarketsSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MarketsSnapshot.Marshal(b, m, deterministic)
+}
+func (dst *MarketsSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketsSnapshot.Merge(dst, src)
+}
+func (m *MarketsSnapshot) XXX_Size() int {
+	return xxx_messageInfo_MarketsSnapshot.Size(m)
+}
+func (m *MarketsSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketsSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketsSnapshot proto.InternalMessageInfo
+
+func (m *MarketsSnapshot) GetMarketsSummary() *MarketsSummary {
+	if m != nil {
+		return m.MarketsSummary
+	}
+	return nil
+}
+
+func (m *MarketsSnapshot) GetMarketInfos() []*MarketInfo {
+	if m != nil {
+		return m.MarketInfos
+	}
+	return nil
+}
+
+// MarketEvents is a feed of the most recent market events, oldest first
+type MarketEvents struct {
+	Block                uint64         `protobuf:"varint,1,opt,name=block,proto3" json:"block,omitempty"`
+	GenerationTime       uint64         `protobuf:"varint,2,opt,name=generation_time,json=generationTime,proto3" json:"generation_time,omitempty"`
+	Events               []*MarketEvent `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *MarketEvents) Reset()         { *m = MarketEvents{} }
+func (m *MarketEvents) String() string { return proto.CompactTextString(m) }
+func (*MarketEvents) ProtoMessage()    {}
+func (*MarketEvents) Descriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{31}
+}
+func (m *MarketEvents) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MarketEvents.Unmarshal(m, b)
+}
+func (m *MarketEvents) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MarketEvents.Marshal(b, m, deterministic)
+}
+func (dst *MarketEvents) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketEvents.Merge(dst, src)
+}
+func (m *MarketEvents) XXX_Size() int {
+	return xxx_messageInfo_MarketEvents.Size(m)
+}
+func (m *MarketEvents) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketEvents.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketEvents proto.InternalMessageInfo
+
+func (m *MarketEvents) GetBlock() uint64 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
+func (m *MarketEvents) GetGenerationTime() uint64 {
+	if m != nil {
+		return m.GenerationTime
+	}
+	return 0
+}
+
+func (m *MarketEvents) GetEvents() []*MarketEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+// MarketEvent describes a change to a market observed between two
+// consecutively processed blocks. Only the before and after fields
+// relevant to the event type are set.
+type MarketEvent struct {
+	Type                 MarketEventType `protobuf:"varint,1,opt,name=type,proto3,enum=markets.MarketEventType" json:"type,omitempty"`
+	Block                uint64          `protobuf:"varint,2,opt,name=block,proto3" json:"block,omitempty"`
+	MarketId             string          `protobuf:"bytes,3,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	OutcomeId            uint64          `protobuf:"varint,4,opt,name=outcome_id,json=outcomeId,proto3" json:"outcome_id,omitempty"`
+	ReportingStateBefore ReportingState  `protobuf:"varint,5,opt,name=reporting_state_before,json=reportingStateBefore,proto3,enum=markets.ReportingState" json:"reporting_state_before,omitempty"`
+	ReportingStateAfter  ReportingState  `protobuf:"varint,6,opt,name=reporting_state_after,json=reportingStateAfter,proto3,enum=markets.ReportingState" json:"reporting_state_after,omitempty"`
+	PredictionBefore     float32         `protobuf:"fixed32,7,opt,name=prediction_before,json=predictionBefore,proto3" json:"prediction_before,omitempty"`
+	PredictionAfter      float32         `protobuf:"fixed32,8,opt,name=prediction_after,json=predictionAfter,proto3" json:"prediction_after,omitempty"`
+	MillietherTranche    uint64          `protobuf:"varint,9,opt,name=milliether_tranche,json=millietherTranche,proto3" json:"milliether_tranche,omitempty"`
+	RetentionRatioBefore float32         `protobuf:"fixed32,10,opt,name=retention_ratio_before,json=retentionRatioBefore,proto3" json:"retention_ratio_before,omitempty"`
+	RetentionRatioAfter  float32         `protobuf:"fixed32,11,opt,name=retention_ratio_after,json=retentionRatioAfter,proto3" json:"retention_ratio_after,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *MarketEvent) Reset()         { *m = MarketEvent{} }
+func (m *MarketEvent) String() string { return proto.CompactTextString(m) }
+func (*MarketEvent) ProtoMessage()    {}
+func (*MarketEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{32}
+}
+func (m *MarketEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MarketEvent.Unmarshal(m, b)
+}
+func (m *MarketEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MarketEvent.Marshal(b, m, deterministic)
+}
+func (dst *MarketEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketEvent.Merge(dst, src)
+}
+func (m *MarketEvent) XXX_Size() int {
+	return xxx_messageInfo_MarketEvent.Size(m)
+}
+func (m *MarketEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketEvent proto.InternalMessageInfo
+
+func (m *MarketEvent) GetType() MarketEventType {
+	if m != nil {
+		return m.Type
+	}
+	return MarketEventType_MARKET_CREATED
+}
+
+func (m *MarketEvent) GetBlock() uint64 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
+func (m *MarketEvent) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
+	}
+	return ""
+}
+
+func (m *MarketEvent) GetOutcomeId() uint64 {
+	if m != nil {
+		return m.OutcomeId
+	}
+	return 0
+}
+
+func (m *MarketEvent) GetReportingStateBefore() ReportingState {
+	if m != nil {
+		return m.ReportingStateBefore
+	}
+	return ReportingState_PRE_REPORTING
+}
+
+func (m *MarketEvent) GetReportingStateAfter() ReportingState {
+	if m != nil {
+		return m.ReportingStateAfter
+	}
+	return ReportingState_PRE_REPORTING
+}
+
+func (m *MarketEvent) GetPredictionBefore() float32 {
+	if m != nil {
+		return m.PredictionBefore
+	}
+	return 0
+}
+
+func (m *MarketEvent) GetPredictionAfter() float32 {
+	if m != nil {
+		return m.PredictionAfter
+	}
+	return 0
+}
+
+func (m *MarketEvent) GetMillietherTranche() uint64 {
+	if m != nil {
+		return m.MillietherTranche
+	}
+	return 0
+}
+
+func (m *MarketEvent) GetRetentionRatioBefore() float32 {
+	if m != nil {
+		return m.RetentionRatioBefore
+	}
+	return 0
+}
+
+func (m *MarketEvent) GetRetentionRatioAfter() float32 {
+	if m != nil {
+		return m.RetentionRatioAfter
+	}
+	return 0
+}
+
+// UniverseHistory charts the growth of the universe. Every snapshot is the
+// last one recorded in its hour or UTC day, oldest first.
+type UniverseHistory struct {
+	GenerationTime       uint64              `protobuf:"varint,1,opt,name=generation_time,json=generationTime,proto3" json:"generation_time,omitempty"`
+	Hourly               []*UniverseSnapshot `protobuf:"bytes,2,rep,name=hourly,proto3" json:"hourly,omitempty"`
+	Daily                []*UniverseSnapshot `protobuf:"bytes,3,rep,name=daily,proto3" json:"daily,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *UniverseHistory) Reset()         { *m = UniverseHistory{} }
+func (m *UniverseHistory) String() string { return proto.CompactTextString(m) }
+func (*UniverseHistory) ProtoMessage()    {}
+func (*UniverseHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{33}
+}
+func (m *UniverseHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UniverseHistory.Unmarshal(m, b)
+}
+func (m *UniverseHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UniverseHistory.Marshal(b, m, deterministic)
+}
+func (dst *UniverseHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UniverseHistory.Merge(dst, src)
+}
+func (m *UniverseHistory) XXX_Size() int {
+	return xxx_messageInfo_UniverseHistory.Size(m)
+}
+func (m *UniverseHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_UniverseHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UniverseHistory proto.InternalMessageInfo
+
+func (m *UniverseHistory) GetGenerationTime() uint64 {
+	if m != nil {
+		return m.GenerationTime
+	}
+	return 0
+}
+
+func (m *UniverseHistory) GetHourly() []*UniverseSnapshot {
+	if m != nil {
+		return m.Hourly
+	}
+	return nil
+}
+
+func (m *UniverseHistory) GetDaily() []*UniverseSnapshot {
+	if m != nil {
+		return m.Daily
+	}
+	return nil
+}
+
+type UniverseSnapshot struct {
+	Block                      uint64 `protobuf:"varint,1,opt,name=block,proto3" json:"block,omitempty"`
+	Time                       uint64 `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	TotalMarkets               uint64 `protobuf:"varint,3,opt,name=total_markets,json=totalMarkets,proto3" json:"total_markets,omitempty"`
+	TotalMarketsCapitalization *Price `protobuf:"bytes,4,opt,name=total_markets_capitalization,json=totalMarketsCapitalization,proto3" json:"total_markets_capitalization,omitempty"`
+	TotalVolume                *Price `protobuf:"bytes,5,opt,name=total_volume,json=totalVolume,proto3" json:"total_volume,omitempty"`
+	TotalOpenOrders            uint64 `protobuf:"varint,6,opt,name=total_open_orders,json=totalOpenOrders,proto3" json:"total_open_orders,omitempty"`
+	// Keyed by the name of the ReportingState
+	TotalMarketsByReportingState map[string]uint64 `protobuf:"bytes,7,rep,name=total_markets_by_reporting_state,json=totalMarketsByReportingState,proto3" json:"total_markets_by_reporting_state,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral         struct{}          `json:"-"`
+	XXX_unrecognized             []byte            `json:"-"`
+	XXX_sizecache                int32             `json:"-"`
 }
-func (m *MarketDataSources) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MarketDataSources.Unmarshal(m, b)
+
+func (m *UniverseSnapshot) Reset()         { *m = UniverseSnapshot{} }
+func (m *UniverseSnapshot) String() string { return proto.CompactTextString(m) }
+func (*UniverseSnapshot) ProtoMessage()    {}
+func (*UniverseSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{34}
 }
-func (m *MarketDataSources) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MarketDataSources.Marshal(b, m, deterministic)
+func (m *UniverseSnapshot) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UniverseSnapshot.Unmarshal(m, b)
 }
-func (dst *MarketDataSources) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MarketDataSources.Merge(dst, src)
+func (m *UniverseSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UniverseSnapshot.Marshal(b, m, deterministic)
 }
-func (m *MarketDataSources) XXX_Size() int {
-	return xxx_messageInfo_MarketDataSources.Size(m)
+func (dst *UniverseSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UniverseSnapshot.Merge(dst, src)
 }
-func (m *MarketDataSources) XXX_DiscardUnknown() {
-	xxx_messageInfo_MarketDataSources.DiscardUnknown(m)
+func (m *UniverseSnapshot) XXX_Size() int {
+	return xxx_messageInfo_UniverseSnapshot.Size(m)
+}
+func (m *UniverseSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_UniverseSnapshot.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MarketDataSources proto.InternalMessageInfo
+var xxx_messageInfo_UniverseSnapshot proto.InternalMessageInfo
 
-func (m *MarketDataSources) GetMarketDetailFileName() string {
+func (m *UniverseSnapshot) GetBlock() uint64 {
 	if m != nil {
-		return m.MarketDetailFileName
+		return m.Block
 	}
-	return ""
+	return 0
 }
 
-type MarketDetailByMarketId struct {
-	MarketDetailByMarketId map[string]*MarketDetail `protobuf:"bytes,1,rep,name=market_detail_by_market_id,json=marketDetailByMarketId,proto3" json:"market_detail_by_market_id,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral   struct{}                 `json:"-"`
-	XXX_unrecognized       []byte                   `json:"-"`
-	XXX_sizecache          int32                    `json:"-"`
+func (m *UniverseSnapshot) GetTime() uint64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
 }
 
-func (m *MarketDetailByMarketId) Reset()         { *m = MarketDetailByMarketId{} }
-func (m *MarketDetailByMarketId) String() string { return proto.CompactTextString(m) }
-func (*MarketDetailByMarketId) ProtoMessage()    {}
-func (*MarketDetailByMarketId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_markets_a7085bb6d3b82a3a, []int{5}
-}
-func (m *MarketDetailByMarketId) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MarketDetailByMarketId.Unmarshal(m, b)
-}
-func (m *MarketDetailByMarketId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MarketDetailByMarketId.Marshal(b, m, deterministic)
-}
-func (dst *MarketDetailByMarketId) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MarketDetailByMarketId.Merge(dst, src)
+func (m *UniverseSnapshot) GetTotalMarkets() uint64 {
+	if m != nil {
+		return m.TotalMarkets
+	}
+	return 0
 }
-func (m *MarketDetailByMarketId) XXX_Size() int {
-	return xxx_messageInfo_MarketDetailByMarketId.Size(m)
+
+func (m *UniverseSnapshot) GetTotalMarketsCapitalization() *Price {
+	if m != nil {
+		return m.TotalMarketsCapitalization
+	}
+	return nil
 }
-func (m *MarketDetailByMarketId) XXX_DiscardUnknown() {
-	xxx_messageInfo_MarketDetailByMarketId.DiscardUnknown(m)
+
+func (m *UniverseSnapshot) GetTotalVolume() *Price {
+	if m != nil {
+		return m.TotalVolume
+	}
+	return nil
 }
 
-var xxx_messageInfo_MarketDetailByMarketId proto.InternalMessageInfo
+func (m *UniverseSnapshot) GetTotalOpenOrders() uint64 {
+	if m != nil {
+		return m.TotalOpenOrders
+	}
+	return 0
+}
 
-func (m *MarketDetailByMarketId) GetMarketDetailByMarketId() map[string]*MarketDetail {
+func (m *UniverseSnapshot) GetTotalMarketsByReportingState() map[string]uint64 {
 	if m != nil {
-		return m.MarketDetailByMarketId
+		return m.TotalMarketsByReportingState
 	}
 	return nil
 }
 
-type MarketDetail struct {
-	MarketId             string      `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	MarketSummary        *Market     `protobuf:"bytes,2,opt,name=market_summary,json=marketSummary,proto3" json:"market_summary,omitempty"`
-	MarketInfo           *MarketInfo `protobuf:"bytes,3,opt,name=market_info,json=marketInfo,proto3" json:"market_info,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+// MarketMakerLeaderboard ranks the addresses providing liquidity to the
+// universe by the depth of their open orders, deepest first
+type MarketMakerLeaderboard struct {
+	Block                uint64         `protobuf:"varint,1,opt,name=block,proto3" json:"block,omitempty"`
+	GenerationTime       uint64         `protobuf:"varint,2,opt,name=generation_time,json=generationTime,proto3" json:"generation_time,omitempty"`
+	MarketMakers         []*MarketMaker `protobuf:"bytes,3,rep,name=market_makers,json=marketMakers,proto3" json:"market_makers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *MarketDetail) Reset()         { *m = MarketDetail{} }
-func (m *MarketDetail) String() string { return proto.CompactTextString(m) }
-func (*MarketDetail) ProtoMessage()    {}
-func (*MarketDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_markets_a7085bb6d3b82a3a, []int{6}
+func (m *MarketMakerLeaderboard) Reset()         { *m = MarketMakerLeaderboard{} }
+func (m *MarketMakerLeaderboard) String() string { return proto.CompactTextString(m) }
+func (*MarketMakerLeaderboard) ProtoMessage()    {}
+func (*MarketMakerLeaderboard) Descriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{35}
 }
-func (m *MarketDetail) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MarketDetail.Unmarshal(m, b)
+func (m *MarketMakerLeaderboard) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MarketMakerLeaderboard.Unmarshal(m, b)
 }
-func (m *MarketDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MarketDetail.Marshal(b, m, deterministic)
+func (m *MarketMakerLeaderboard) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MarketMakerLeaderboard.Marshal(b, m, deterministic)
 }
-func (dst *MarketDetail) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MarketDetail.Merge(dst, src)
+func (dst *MarketMakerLeaderboard) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketMakerLeaderboard.Merge(dst, src)
 }
-func (m *MarketDetail) XXX_Size() int {
-	return xxx_messageInfo_MarketDetail.Size(m)
+func (m *MarketMakerLeaderboard) XXX_Size() int {
+	return xxx_messageInfo_MarketMakerLeaderboard.Size(m)
 }
-func (m *MarketDetail) XXX_DiscardUnknown() {
-	xxx_messageInfo_MarketDetail.DiscardUnknown(m)
+func (m *MarketMakerLeaderboard) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketMakerLeaderboard.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MarketDetail proto.InternalMessageInfo
+var xxx_messageInfo_MarketMakerLeaderboard proto.InternalMessageInfo
 
-func (m *MarketDetail) GetMarketId() string {
+func (m *MarketMakerLeaderboard) GetBlock() uint64 {
 	if m != nil {
-		return m.MarketId
+		return m.Block
 	}
-	return ""
+	return 0
 }
 
-func (m *MarketDetail) GetMarketSummary() *Market {
+func (m *MarketMakerLeaderboard) GetGenerationTime() uint64 {
 	if m != nil {
-		return m.MarketSummary
+		return m.GenerationTime
 	}
-	return nil
+	return 0
 }
 
-func (m *MarketDetail) GetMarketInfo() *MarketInfo {
+func (m *MarketMakerLeaderboard) GetMarketMakers() []*MarketMaker {
 	if m != nil {
-		return m.MarketInfo
+		return m.MarketMakers
 	}
 	return nil
 }
 
-type Prediction struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Percent              float32  `protobuf:"fixed32,2,opt,name=percent,proto3" json:"percent,omitempty"`
-	Value                float32  `protobuf:"fixed32,3,opt,name=value,proto3" json:"value,omitempty"`
-	OutcomeId            uint64   `protobuf:"varint,4,opt,name=outcome_id,json=outcomeId,proto3" json:"outcome_id,omitempty"`
+type MarketMaker struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Value at risk in open orders, bids valued against the minimum price of
+	// the market and asks against the maximum price
+	TotalDepth    *Price `protobuf:"bytes,2,opt,name=total_depth,json=totalDepth,proto3" json:"total_depth,omitempty"`
+	MarketsQuoted uint64 `protobuf:"varint,3,opt,name=markets_quoted,json=marketsQuoted,proto3" json:"markets_quoted,omitempty"`
+	OpenOrders    uint64 `protobuf:"varint,4,opt,name=open_orders,json=openOrders,proto3" json:"open_orders,omitempty"`
+	// Average spread between the best bid and best ask of the address on the
+	// outcomes it quotes on both sides, as a fraction of the price range
+	AverageSpread float32 `protobuf:"fixed32,5,opt,name=average_spread,json=averageSpread,proto3" json:"average_spread,omitempty"`
+	// Fraction of the time observed by the analyzer the address had open orders
+	Presence             float32  `protobuf:"fixed32,6,opt,name=presence,proto3" json:"presence,omitempty"`
+	MarketIds            []string `protobuf:"bytes,7,rep,name=market_ids,json=marketIds,proto3" json:"market_ids,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Prediction) Reset()         { *m = Prediction{} }
-func (m *Prediction) String() string { return proto.CompactTextString(m) }
-func (*Prediction) ProtoMessage()    {}
-func (*Prediction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_markets_a7085bb6d3b82a3a, []int{7}
+func (m *MarketMaker) Reset()         { *m = MarketMaker{} }
+func (m *MarketMaker) String() string { return proto.CompactTextString(m) }
+func (*MarketMaker) ProtoMessage()    {}
+func (*MarketMaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{36}
 }
-func (m *Prediction) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Prediction.Unmarshal(m, b)
+func (m *MarketMaker) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MarketMaker.Unmarshal(m, b)
 }
-func (m *Prediction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Prediction.Marshal(b, m, deterministic)
+func (m *MarketMaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MarketMaker.Marshal(b, m, deterministic)
 }
-func (dst *Prediction) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Prediction.Merge(dst, src)
+func (dst *MarketMaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketMaker.Merge(dst, src)
 }
-func (m *Prediction) XXX_Size() int {
-	return xxx_messageInfo_Prediction.Size(m)
+func (m *MarketMaker) XXX_Size() int {
+	return xxx_messageInfo_MarketMaker.Size(m)
 }
-func (m *Prediction) XXX_DiscardUnknown() {
-	xxx_messageInfo_Prediction.DiscardUnknown(m)
+func (m *MarketMaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketMaker.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Prediction proto.InternalMessageInfo
+var xxx_messageInfo_MarketMaker proto.InternalMessageInfo
 
-func (m *Prediction) GetName() string {
+func (m *MarketMaker) GetAddress() string {
 	if m != nil {
-		return m.Name
+		return m.Address
 	}
 	return ""
 }
 
-func (m *Prediction) GetPercent() float32 {
+func (m *MarketMaker) GetTotalDepth() *Price {
 	if m != nil {
-		return m.Percent
+		return m.TotalDepth
 	}
-	return 0
+	return nil
 }
 
-func (m *Prediction) GetValue() float32 {
+func (m *MarketMaker) GetMarketsQuoted() uint64 {
 	if m != nil {
-		return m.Value
+		return m.MarketsQuoted
 	}
 	return 0
 }
 
-func (m *Prediction) GetOutcomeId() uint64 {
+func (m *MarketMaker) GetOpenOrders() uint64 {
 	if m != nil {
-		return m.OutcomeId
+		return m.OpenOrders
 	}
 	return 0
 }
 
-type LiquidityMetrics struct {
-	RetentionRatioByMillietherTranche map[uint64]float32 `protobuf:"bytes,1,rep,name=retention_ratio_by_milliether_tranche,json=retentionRatioByMillietherTranche,proto3" json:"retention_ratio_by_milliether_tranche,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral              struct{}           `json:"-"`
-	XXX_unrecognized                  []byte             `json:"-"`
-	XXX_sizecache                     int32              `json:"-"`
+func (m *MarketMaker) GetAverageSpread() float32 {
+	if m != nil {
+		return m.AverageSpread
+	}
+	return 0
 }
 
-func (m *LiquidityMetrics) Reset()         { *m = LiquidityMetrics{} }
-func (m *LiquidityMetrics) String() string { return proto.CompactTextString(m) }
-func (*LiquidityMetrics) ProtoMessage()    {}
-func (*LiquidityMetrics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_markets_a7085bb6d3b82a3a, []int{8}
-}
-func (m *LiquidityMetrics) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LiquidityMetrics.Unmarshal(m, b)
-}
-func (m *LiquidityMetrics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LiquidityMetrics.Marshal(b, m, deterministic)
-}
-func (dst *LiquidityMetrics) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LiquidityMetrics.Merge(dst, src)
-}
-func (m *LiquidityMetrics) XXX_Size() int {
-	return xxx_messageInfo_LiquidityMetrics.Size(m)
-}
-func (m *LiquidityMetrics) XXX_DiscardUnknown() {
-	xxx_messageInfo_LiquidityMetrics.DiscardUnknown(m)
+func (m *MarketMaker) GetPresence() float32 {
+	if m != nil {
+		return m.Presence
+	}
+	return 0
 }
 
-var xxx_messageInfo_LiquidityMetrics proto.InternalMessageInfo
-
-func (m *LiquidityMetrics) GetRetentionRatioByMillietherTranche() map[uint64]float32 {
+func (m *MarketMaker) GetMarketIds() []string {
 	if m != nil {
-		return m.RetentionRatioByMillietherTranche
+		return m.MarketIds
 	}
 	return nil
 }
 
-// LiquidityAtPrice represents a single price point in a market outcome's Order book.
-// Note that one bid LiquidityAtPrice may represent an aggregation of N Orders.
-type LiquidityAtPrice struct {
-	Price                float32  `protobuf:"fixed32,1,opt,name=price,proto3" json:"price,omitempty"`
-	Amount               float32  `protobuf:"fixed32,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// ArbitrageOpportunities lists order books which can be traded against each
+// other or against complete sets for a riskless profit, before fees and gas.
+// Ordered by decreasing profit.
+type ArbitrageOpportunities struct {
+	Block                uint64                  `protobuf:"varint,1,opt,name=block,proto3" json:"block,omitempty"`
+	GenerationTime       uint64                  `protobuf:"varint,2,opt,name=generation_time,json=generationTime,proto3" json:"generation_time,omitempty"`
+	Opportunities        []*ArbitrageOpportunity `protobuf:"bytes,3,rep,name=opportunities,proto3" json:"opportunities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
-func (m *LiquidityAtPrice) Reset()         { *m = LiquidityAtPrice{} }
-func (m *LiquidityAtPrice) String() string { return proto.CompactTextString(m) }
-func (*LiquidityAtPrice) ProtoMessage()    {}
-func (*LiquidityAtPrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_markets_a7085bb6d3b82a3a, []int{9}
+func (m *ArbitrageOpportunities) Reset()         { *m = ArbitrageOpportunities{} }
+func (m *ArbitrageOpportunities) String() string { return proto.CompactTextString(m) }
+func (*ArbitrageOpportunities) ProtoMessage()    {}
+func (*ArbitrageOpportunities) Descriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{37}
 }
-func (m *LiquidityAtPrice) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LiquidityAtPrice.Unmarshal(m, b)
+func (m *ArbitrageOpportunities) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArbitrageOpportunities.Unmarshal(m, b)
 }
-func (m *LiquidityAtPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LiquidityAtPrice.Marshal(b, m, deterministic)
+func (m *ArbitrageOpportunities) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArbitrageOpportunities.Marshal(b, m, deterministic)
 }
-func (dst *LiquidityAtPrice) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LiquidityAtPrice.Merge(dst, src)
+func (dst *ArbitrageOpportunities) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArbitrageOpportunities.Merge(dst, src)
 }
-func (m *LiquidityAtPrice) XXX_Size() int {
-	return xxx_messageInfo_LiquidityAtPrice.Size(m)
+func (m *ArbitrageOpportunities) XXX_Size() int {
+	return xxx_messageInfo_ArbitrageOpportunities.Size(m)
 }
-func (m *LiquidityAtPrice) XXX_DiscardUnknown() {
-	xxx_messageInfo_LiquidityAtPrice.DiscardUnknown(m)
+func (m *ArbitrageOpportunities) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArbitrageOpportunities.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LiquidityAtPrice proto.InternalMessageInfo
+var xxx_messageInfo_ArbitrageOpportunities proto.InternalMessageInfo
 
-func (m *LiquidityAtPrice) GetPrice() float32 {
+func (m *ArbitrageOpportunities) GetBlock() uint64 {
 	if m != nil {
-		return m.Price
+		return m.Block
 	}
 	return 0
 }
 
-func (m *LiquidityAtPrice) GetAmount() float32 {
+func (m *ArbitrageOpportunities) GetGenerationTime() uint64 {
 	if m != nil {
-		return m.Amount
+		return m.GenerationTime
 	}
 	return 0
 }
 
-type ListLiquidityAtPrice struct {
-	LiquidityAtPrice     []*LiquidityAtPrice `protobuf:"bytes,1,rep,name=liquidity_at_price,json=liquidityAtPrice,proto3" json:"liquidity_at_price,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+func (m *ArbitrageOpportunities) GetOpportunities() []*ArbitrageOpportunity {
+	if m != nil {
+		return m.Opportunities
+	}
+	return nil
 }
 
-func (m *ListLiquidityAtPrice) Reset()         { *m = ListLiquidityAtPrice{} }
-func (m *ListLiquidityAtPrice) String() string { return proto.CompactTextString(m) }
-func (*ListLiquidityAtPrice) ProtoMessage()    {}
-func (*ListLiquidityAtPrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_markets_a7085bb6d3b82a3a, []int{10}
+type ArbitrageOpportunity struct {
+	Type     ArbitrageType `protobuf:"varint,1,opt,name=type,proto3,enum=markets.ArbitrageType" json:"type,omitempty"`
+	MarketId string        `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// Only set for crossed books
+	OutcomeId uint64 `protobuf:"varint,3,opt,name=outcome_id,json=outcomeId,proto3" json:"outcome_id,omitempty"`
+	// Complete sets, or shares for crossed books, which can be traded at a
+	// profit by walking the order books
+	Size float32 `protobuf:"fixed32,4,opt,name=size,proto3" json:"size,omitempty"`
+	// Profit in ETH of trading the full size
+	MaxProfit float32 `protobuf:"fixed32,5,opt,name=max_profit,json=maxProfit,proto3" json:"max_profit,omitempty"`
+	// Profit in ETH of trading the first complete set or share
+	ProfitPerUnit        float32  `protobuf:"fixed32,6,opt,name=profit_per_unit,json=profitPerUnit,proto3" json:"profit_per_unit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *ListLiquidityAtPrice) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListLiquidityAtPrice.Unmarshal(m, b)
+
+func (m *ArbitrageOpportunity) Reset()         { *m = ArbitrageOpportunity{} }
+func (m *ArbitrageOpportunity) String() string { return proto.CompactTextString(m) }
+func (*ArbitrageOpportunity) ProtoMessage()    {}
+func (*ArbitrageOpportunity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{38}
 }
-func (m *ListLiquidityAtPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListLiquidityAtPrice.Marshal(b, m, deterministic)
+func (m *ArbitrageOpportunity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArbitrageOpportunity.Unmarshal(m, b)
 }
-func (dst *ListLiquidityAtPrice) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListLiquidityAtPrice.Merge(dst, src)
+func (m *ArbitrageOpportunity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArbitrageOpportunity.Marshal(b, m, deterministic)
 }
-func (m *ListLiquidityAtPrice) XXX_Size() int {
-	return xxx_messageInfo_ListLiquidityAtPrice.Size(m)
+func (dst *ArbitrageOpportunity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArbitrageOpportunity.Merge(dst, src)
 }
-func (m *ListLiquidityAtPrice) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListLiquidityAtPrice.DiscardUnknown(m)
+func (m *ArbitrageOpportunity) XXX_Size() int {
+	return xxx_messageInfo_ArbitrageOpportunity.Size(m)
+}
+func (m *ArbitrageOpportunity) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArbitrageOpportunity.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListLiquidityAtPrice proto.InternalMessageInfo
+var xxx_messageInfo_ArbitrageOpportunity proto.InternalMessageInfo
 
-func (m *ListLiquidityAtPrice) GetLiquidityAtPrice() []*LiquidityAtPrice {
+func (m *ArbitrageOpportunity) GetType() ArbitrageType {
 	if m != nil {
-		return m.LiquidityAtPrice
+		return m.Type
 	}
-	return nil
+	return ArbitrageType_ASKS_BELOW_COMPLETE_SET
 }
 
-type MarketsSnapshot struct {
-	MarketsSummary       *MarketsSummary `protobuf:"bytes,1,opt,name=markets_summary,json=marketsSummary,proto3" json:"markets_summary,omitempty"`
-	MarketInfos          []*MarketInfo   `protobuf:"bytes,2,rep,name=market_infos,json=marketInfos,proto3" json:"market_infos,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+func (m *ArbitrageOpportunity) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
+	}
+	return ""
 }
 
-func (m *MarketsSnapshot) Reset()         { *m = MarketsSnapshot{} }
-func (m *MarketsSnapshot) String() string { return proto.CompactTextString(m) }
-func (*MarketsSnapshot) ProtoMessage()    {}
-func (*MarketsSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_markets_a7085bb6d3b82a3a, []int{11}
-}
-func (m *MarketsSnapshot) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MarketsSnapshot.Unmarshal(m, b)
-}
-func (m *MarketsSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MarketsSnapshot.Marshal(b, m, deterministic)
-}
-func (dst *MarketsSnapshot) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MarketsSnapshot.Merge(dst, src)
-}
-func (m *MarketsSnapshot) XXX_Size() int {
-	return xxx_messageInfo_MarketsSnapshot.Size(m)
-}
-func (m *MarketsSnapshot) XXX_DiscardUnknown() {
-	xxx_messageInfo_MarketsSnapshot.DiscardUnknown(m)
+func (m *ArbitrageOpportunity) GetOutcomeId() uint64 {
+	if m != nil {
+		return m.OutcomeId
+	}
+	return 0
 }
 
-var xxx_messageInfo_MarketsSnapshot proto.InternalMessageInfo
+func (m *ArbitrageOpportunity) GetSize() float32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
 
-func (m *MarketsSnapshot) GetMarketsSummary() *MarketsSummary {
+func (m *ArbitrageOpportunity) GetMaxProfit() float32 {
 	if m != nil {
-		return m.MarketsSummary
+		return m.MaxProfit
 	}
-	return nil
+	return 0
 }
 
-func (m *MarketsSnapshot) GetMarketInfos() []*MarketInfo {
+func (m *ArbitrageOpportunity) GetProfitPerUnit() float32 {
 	if m != nil {
-		return m.MarketInfos
+		return m.ProfitPerUnit
 	}
-	return nil
+	return 0
 }
 
 type MarketInfo struct {
@@ -889,7 +3344,7 @@ func (m *MarketInfo) Reset()         { *m = MarketInfo{} }
 func (m *MarketInfo) String() string { return proto.CompactTextString(m) }
 func (*MarketInfo) ProtoMessage()    {}
 func (*MarketInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_markets_a7085bb6d3b82a3a, []int{12}
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{39}
 }
 func (m *MarketInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MarketInfo.Unmarshal(m, b)
@@ -1208,7 +3663,7 @@ func (m *NormalizedPayout) Reset()         { *m = NormalizedPayout{} }
 func (m *NormalizedPayout) String() string { return proto.CompactTextString(m) }
 func (*NormalizedPayout) ProtoMessage()    {}
 func (*NormalizedPayout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_markets_a7085bb6d3b82a3a, []int{13}
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{40}
 }
 func (m *NormalizedPayout) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NormalizedPayout.Unmarshal(m, b)
@@ -1256,7 +3711,7 @@ func (m *OutcomeInfo) Reset()         { *m = OutcomeInfo{} }
 func (m *OutcomeInfo) String() string { return proto.CompactTextString(m) }
 func (*OutcomeInfo) ProtoMessage()    {}
 func (*OutcomeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_markets_a7085bb6d3b82a3a, []int{14}
+	return fileDescriptor_markets_db299e8d6040dbc2, []int{41}
 }
 func (m *OutcomeInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OutcomeInfo.Unmarshal(m, b)
@@ -1306,158 +3761,417 @@ func (m *OutcomeInfo) GetDescription() string {
 
 func init() {
 	proto.RegisterType((*MarketsSummary)(nil), "markets.MarketsSummary")
+	proto.RegisterType((*CategoriesSummary)(nil), "markets.CategoriesSummary")
+	proto.RegisterType((*CategoryStatistics)(nil), "markets.CategoryStatistics")
+	proto.RegisterMapType((map[uint64]float32)(nil), "markets.CategoryStatistics.AverageRetentionRatioByMillietherTrancheEntry")
+	proto.RegisterMapType((map[string]uint64)(nil), "markets.CategoryStatistics.TotalMarketsByReportingStateEntry")
 	proto.RegisterType((*LiquidityMetricsConfig)(nil), "markets.LiquidityMetricsConfig")
+	proto.RegisterMapType((map[uint64]float32)(nil), "markets.LiquidityMetricsConfig.MillietherByUsdTrancheEntry")
+	proto.RegisterMapType((map[string]*LiquidityTranches)(nil), "markets.LiquidityMetricsConfig.TranchesByMarketTypeEntry")
+	proto.RegisterMapType((map[uint64]float32)(nil), "markets.LiquidityMetricsConfig.UsdByMillietherTrancheEntry")
+	proto.RegisterType((*LiquidityTranches)(nil), "markets.LiquidityTranches")
 	proto.RegisterType((*Price)(nil), "markets.Price")
 	proto.RegisterType((*Market)(nil), "markets.Market")
 	proto.RegisterMapType((map[uint64]*ListLiquidityAtPrice)(nil), "markets.Market.AsksEntry")
 	proto.RegisterMapType((map[uint64]*LiquidityAtPrice)(nil), "markets.Market.BestAsksEntry")
 	proto.RegisterMapType((map[uint64]*LiquidityAtPrice)(nil), "markets.Market.BestBidsEntry")
 	proto.RegisterMapType((map[uint64]*ListLiquidityAtPrice)(nil), "markets.Market.BidsEntry")
+	proto.RegisterMapType((map[uint64]*OutcomeOrderBookMetrics)(nil), "markets.Market.OrderBookMetricsByOutcomeEntry")
+	proto.RegisterMapType((map[uint64]*OutcomeTradingActivity)(nil), "markets.Market.TradingActivityByOutcomeEntry")
+	proto.RegisterType((*OutcomeOrderBookMetrics)(nil), "markets.OutcomeOrderBookMetrics")
+	proto.RegisterMapType((map[uint32]*OrderBookDepth)(nil), "markets.OutcomeOrderBookMetrics.DepthByPercentFromMidEntry")
+	proto.RegisterType((*OrderBookDepth)(nil), "markets.OrderBookDepth")
+	proto.RegisterType((*OutcomeTradingActivity)(nil), "markets.OutcomeTradingActivity")
 	proto.RegisterType((*MarketDataSources)(nil), "markets.MarketDataSources")
 	proto.RegisterType((*MarketDetailByMarketId)(nil), "markets.MarketDetailByMarketId")
 	proto.RegisterMapType((map[string]*MarketDetail)(nil), "markets.MarketDetailByMarketId.MarketDetailByMarketIdEntry")
 	proto.RegisterType((*MarketDetail)(nil), "markets.MarketDetail")
+	proto.RegisterMapType((map[uint64]*PriceImpactCurves)(nil), "markets.MarketDetail.PriceImpactByOutcomeEntry")
+	proto.RegisterType((*PriceImpactCurves)(nil), "markets.PriceImpactCurves")
+	proto.RegisterType((*PriceImpactPoint)(nil), "markets.PriceImpactPoint")
+	proto.RegisterType((*ScalarDistribution)(nil), "markets.ScalarDistribution")
+	proto.RegisterMapType((map[uint32]float32)(nil), "markets.ScalarDistribution.ValueByPercentileEntry")
+	proto.RegisterType((*ScalarDistributionBin)(nil), "markets.ScalarDistributionBin")
+	proto.RegisterType((*PredictionVariant)(nil), "markets.PredictionVariant")
+	proto.RegisterType((*OrderBookAnalytics)(nil), "markets.OrderBookAnalytics")
+	proto.RegisterMapType((map[uint64]*OrderStatistics)(nil), "markets.OrderBookAnalytics.ByOutcomeEntry")
+	proto.RegisterType((*OrderStatistics)(nil), "markets.OrderStatistics")
 	proto.RegisterType((*Prediction)(nil), "markets.Prediction")
 	proto.RegisterType((*LiquidityMetrics)(nil), "markets.LiquidityMetrics")
+	proto.RegisterMapType((map[uint64]*OutcomeEntryCosts)(nil), "markets.LiquidityMetrics.EntryCostsByOutcomeEntry")
+	proto.RegisterMapType((map[uint64]*ExecutionCost)(nil), "markets.LiquidityMetrics.ExecutionCostByMillietherTrancheEntry")
+	proto.RegisterMapType((map[uint64]*ExecutionCost)(nil), "markets.LiquidityMetrics.ExecutionCostByUsdTrancheEntry")
+	proto.RegisterMapType((map[uint64]float32)(nil), "markets.LiquidityMetrics.NetRetentionRatioByMillietherTrancheEntry")
+	proto.RegisterMapType((map[uint64]float32)(nil), "markets.LiquidityMetrics.NetRetentionRatioByUsdTrancheEntry")
 	proto.RegisterMapType((map[uint64]float32)(nil), "markets.LiquidityMetrics.RetentionRatioByMillietherTrancheEntry")
+	proto.RegisterMapType((map[uint64]float32)(nil), "markets.LiquidityMetrics.RetentionRatioByUsdTrancheEntry")
+	proto.RegisterType((*ExecutionCost)(nil), "markets.ExecutionCost")
+	proto.RegisterType((*OutcomeEntryCosts)(nil), "markets.OutcomeEntryCosts")
+	proto.RegisterMapType((map[uint64]*EntryCost)(nil), "markets.OutcomeEntryCosts.BuyByMillietherTrancheEntry")
+	proto.RegisterMapType((map[uint64]*EntryCost)(nil), "markets.OutcomeEntryCosts.BuyByUsdTrancheEntry")
+	proto.RegisterMapType((map[uint64]*EntryCost)(nil), "markets.OutcomeEntryCosts.ShortByMillietherTrancheEntry")
+	proto.RegisterMapType((map[uint64]*EntryCost)(nil), "markets.OutcomeEntryCosts.ShortByUsdTrancheEntry")
+	proto.RegisterType((*EntryCost)(nil), "markets.EntryCost")
+	proto.RegisterType((*LiquidityTrace)(nil), "markets.LiquidityTrace")
+	proto.RegisterType((*LiquidityTraceIncrement)(nil), "markets.LiquidityTraceIncrement")
+	proto.RegisterType((*LiquidityTraceFill)(nil), "markets.LiquidityTraceFill")
 	proto.RegisterType((*LiquidityAtPrice)(nil), "markets.LiquidityAtPrice")
 	proto.RegisterType((*ListLiquidityAtPrice)(nil), "markets.ListLiquidityAtPrice")
 	proto.RegisterType((*MarketsSnapshot)(nil), "markets.MarketsSnapshot")
+	proto.RegisterType((*MarketEvents)(nil), "markets.MarketEvents")
+	proto.RegisterType((*MarketEvent)(nil), "markets.MarketEvent")
+	proto.RegisterType((*UniverseHistory)(nil), "markets.UniverseHistory")
+	proto.RegisterType((*UniverseSnapshot)(nil), "markets.UniverseSnapshot")
+	proto.RegisterMapType((map[string]uint64)(nil), "markets.UniverseSnapshot.TotalMarketsByReportingStateEntry")
+	proto.RegisterType((*MarketMakerLeaderboard)(nil), "markets.MarketMakerLeaderboard")
+	proto.RegisterType((*MarketMaker)(nil), "markets.MarketMaker")
+	proto.RegisterType((*ArbitrageOpportunities)(nil), "markets.ArbitrageOpportunities")
+	proto.RegisterType((*ArbitrageOpportunity)(nil), "markets.ArbitrageOpportunity")
 	proto.RegisterType((*MarketInfo)(nil), "markets.MarketInfo")
 	proto.RegisterType((*NormalizedPayout)(nil), "markets.NormalizedPayout")
 	proto.RegisterType((*OutcomeInfo)(nil), "markets.OutcomeInfo")
 	proto.RegisterEnum("markets.MarketType", MarketType_name, MarketType_value)
 	proto.RegisterEnum("markets.ReportingState", ReportingState_name, ReportingState_value)
-}
-
-func init() { proto.RegisterFile("markets.proto", fileDescriptor_markets_a7085bb6d3b82a3a) }
-
-var fileDescriptor_markets_a7085bb6d3b82a3a = []byte{
-	// 2014 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x5b, 0x73, 0x1b, 0x49,
-	0x15, 0x5e, 0xc9, 0xf2, 0x45, 0x47, 0xb6, 0x2c, 0xb7, 0x6f, 0x63, 0x3b, 0x21, 0x8e, 0x96, 0x64,
-	0x9d, 0x5d, 0x48, 0xa8, 0x6c, 0x36, 0x50, 0x4b, 0x6d, 0x11, 0xd9, 0x96, 0x5d, 0xda, 0x8d, 0x25,
-	0x57, 0x4b, 0x4b, 0x58, 0x5e, 0x86, 0xf1, 0x4c, 0xdb, 0xee, 0xf2, 0x5c, 0xc4, 0x74, 0x4f, 0x12,
-	0xe5, 0x89, 0x77, 0xde, 0x78, 0xe3, 0x9f, 0xf0, 0x23, 0xf8, 0x37, 0xbc, 0x42, 0x15, 0xd5, 0xa7,
-	0x7b, 0x2e, 0x1a, 0xcb, 0x59, 0x8a, 0xa2, 0x78, 0x9b, 0x3e, 0xb7, 0xee, 0x3e, 0xfa, 0xce, 0x39,
-	0x5f, 0x0b, 0x56, 0x02, 0x27, 0xbe, 0x61, 0x52, 0x3c, 0x1d, 0xc7, 0x91, 0x8c, 0xc8, 0xa2, 0x59,
-	0xb6, 0xff, 0x5e, 0x85, 0xe6, 0x99, 0xfe, 0x1e, 0x26, 0x41, 0xe0, 0xc4, 0x13, 0xb2, 0x01, 0xf3,
-	0x17, 0x7e, 0xe4, 0xde, 0x58, 0x95, 0xfd, 0xca, 0x41, 0x8d, 0xea, 0x05, 0xf9, 0x14, 0x56, 0x64,
-	0x24, 0x1d, 0xdf, 0x36, 0x9e, 0x56, 0x15, 0xb5, 0xcb, 0x28, 0x34, 0x11, 0xc8, 0x39, 0xdc, 0x9b,
-	0x32, 0xb2, 0x5d, 0x67, 0xcc, 0xa5, 0xe3, 0xf3, 0x0f, 0x8e, 0xe4, 0x51, 0x68, 0xcd, 0xed, 0x57,
-	0x0e, 0x1a, 0xcf, 0x9b, 0x4f, 0xd3, 0xc3, 0x9c, 0xc7, 0xdc, 0x65, 0x74, 0xb7, 0x18, 0xe3, 0x68,
-	0xca, 0x83, 0x3c, 0x81, 0xf4, 0xa8, 0x56, 0x6d, 0x7f, 0xee, 0xa0, 0xf1, 0x7c, 0x35, 0x73, 0xd6,
-	0x0e, 0x34, 0xd5, 0x93, 0xcf, 0x60, 0xf5, 0x8a, 0x85, 0x2c, 0x46, 0x47, 0x5b, 0xf2, 0x80, 0x59,
-	0xf3, 0x78, 0xc6, 0x66, 0x2e, 0x1e, 0xf1, 0x80, 0x91, 0x1f, 0xc0, 0xf2, 0xf9, 0x1f, 0x13, 0xee,
-	0x71, 0x39, 0xb1, 0x03, 0x26, 0x63, 0xee, 0x0a, 0xdb, 0x8d, 0xc2, 0x4b, 0x7e, 0x65, 0x2d, 0xe0,
-	0x09, 0x1f, 0x64, 0x9b, 0xbc, 0x4e, 0x0d, 0xcf, 0xb4, 0xdd, 0x11, 0x9a, 0xd1, 0x2d, 0x7f, 0xa6,
-	0xbc, 0xdd, 0x83, 0xad, 0xd9, 0x1e, 0xe4, 0x19, 0xac, 0x07, 0xdc, 0xf7, 0x39, 0x93, 0xd7, 0x2c,
-	0xb6, 0x65, 0xec, 0x84, 0xee, 0x35, 0x13, 0x56, 0x65, 0x7f, 0xee, 0xa0, 0x46, 0x49, 0xae, 0x1a,
-	0x19, 0x4d, 0xfb, 0x1b, 0x98, 0xc7, 0xf4, 0x90, 0x16, 0xcc, 0x31, 0x79, 0x8d, 0xbf, 0x46, 0x95,
-	0xaa, 0x4f, 0x25, 0x49, 0x84, 0x87, 0xbf, 0x40, 0x95, 0xaa, 0x4f, 0x25, 0xb9, 0x90, 0x2e, 0xe6,
-	0xb7, 0x4a, 0xd5, 0x67, 0xfb, 0x6f, 0x00, 0x0b, 0x3a, 0x43, 0xa4, 0x09, 0x55, 0xee, 0xa1, 0x7f,
-	0x9d, 0x56, 0xb9, 0x47, 0x5e, 0x40, 0x43, 0x5f, 0xcf, 0x96, 0x93, 0x31, 0xc3, 0x30, 0xcd, 0xe7,
-	0xeb, 0xa5, 0xbc, 0x8e, 0x26, 0x63, 0x46, 0x21, 0xc8, 0xbe, 0x09, 0x81, 0x5a, 0xe8, 0x04, 0x0c,
-	0xf7, 0xa8, 0x53, 0xfc, 0x56, 0xa0, 0x70, 0xa3, 0x20, 0x60, 0xa1, 0xb4, 0xdd, 0x28, 0x09, 0xa5,
-	0x55, 0xdb, 0xaf, 0x1c, 0xac, 0xd0, 0x65, 0x23, 0x3c, 0x52, 0x32, 0x72, 0x04, 0x9b, 0x66, 0xbb,
-	0x12, 0x1a, 0xe6, 0x67, 0xa2, 0x61, 0x43, 0x2f, 0x4b, 0x38, 0xd8, 0x81, 0x25, 0x16, 0x7a, 0xb6,
-	0xe7, 0x48, 0x86, 0xbf, 0x51, 0x8d, 0x2e, 0xb2, 0xd0, 0x3b, 0x76, 0x24, 0x23, 0x5f, 0x41, 0x63,
-	0x1c, 0x33, 0x8f, 0xbb, 0xca, 0x50, 0x58, 0x8b, 0x08, 0x93, 0xf5, 0x42, 0xd4, 0x54, 0x47, 0x8b,
-	0x76, 0x64, 0x0b, 0x16, 0x9c, 0x44, 0x5e, 0x47, 0xb1, 0xb5, 0x84, 0x37, 0x32, 0x2b, 0xbc, 0x53,
-	0xcc, 0x0a, 0x20, 0xaa, 0x6b, 0xa0, 0xa7, 0x42, 0x84, 0xd0, 0x23, 0x68, 0x66, 0x46, 0xba, 0x58,
-	0x00, 0xad, 0x32, 0xd7, 0x43, 0x2c, 0x9a, 0x2f, 0x60, 0x2d, 0x66, 0x22, 0xf2, 0x13, 0x34, 0x14,
-	0x51, 0x12, 0xbb, 0xcc, 0x6a, 0xe0, 0x76, 0xad, 0x5c, 0x31, 0x44, 0x39, 0xb9, 0x07, 0x8b, 0x1e,
-	0x93, 0x0e, 0xf7, 0x85, 0xb5, 0xac, 0x4c, 0x0e, 0xab, 0x56, 0x85, 0xa6, 0x22, 0x95, 0x7e, 0xe9,
-	0x5c, 0x09, 0x6b, 0x65, 0x7f, 0x4e, 0xa5, 0x5f, 0x7d, 0x93, 0x07, 0xd0, 0xe0, 0xc2, 0xbe, 0x64,
-	0x8e, 0x4c, 0x62, 0xe6, 0x59, 0xcd, 0xfd, 0xca, 0xc1, 0x12, 0x05, 0x2e, 0x4e, 0x8c, 0x84, 0xec,
-	0xc2, 0x92, 0xeb, 0x48, 0x76, 0x15, 0xc5, 0x13, 0x6b, 0x15, 0xb7, 0xcd, 0xd6, 0xe4, 0x31, 0xac,
-	0xfa, 0x8e, 0x90, 0x0a, 0x8a, 0x1e, 0xd3, 0x37, 0x6d, 0xe9, 0x3b, 0x28, 0xf1, 0x48, 0x49, 0xf1,
-	0xaa, 0x5f, 0x43, 0xfd, 0x82, 0x09, 0x69, 0x5f, 0x70, 0x4f, 0x58, 0x6b, 0x98, 0xdc, 0xfb, 0x25,
-	0xac, 0x3c, 0x3d, 0x64, 0x42, 0x1e, 0x72, 0x4f, 0x74, 0x43, 0x19, 0x4f, 0xe8, 0xd2, 0x85, 0x59,
-	0x66, 0xbe, 0x8e, 0xb8, 0x11, 0x16, 0xb9, 0xdb, 0xb7, 0x23, 0x6e, 0x8a, 0xbe, 0x6a, 0x49, 0x1e,
-	0xc3, 0xc2, 0xdb, 0xc8, 0x4f, 0x02, 0x66, 0xad, 0xcf, 0xc4, 0x89, 0xd1, 0x92, 0x9f, 0x43, 0x0d,
-	0x8f, 0xb6, 0x81, 0xe1, 0x77, 0x6e, 0x85, 0xcf, 0x8e, 0x85, 0x66, 0xca, 0x1c, 0x4f, 0xb3, 0x39,
-	0xdb, 0x3c, 0x3f, 0x09, 0x9a, 0x91, 0x13, 0x58, 0xbb, 0xd5, 0x2b, 0xac, 0x2d, 0x3c, 0xd0, 0xce,
-	0x9d, 0x4d, 0x82, 0xb6, 0xca, 0xed, 0x81, 0x7c, 0x0b, 0xeb, 0xa6, 0x08, 0x3c, 0x47, 0x3a, 0x06,
-	0x0a, 0xc2, 0xda, 0xc6, 0x48, 0xbb, 0xa5, 0x53, 0x1c, 0x3b, 0xd2, 0xd1, 0xa0, 0x10, 0x74, 0x2d,
-	0x28, 0x8b, 0x76, 0x7f, 0x0b, 0x2b, 0x53, 0x09, 0x57, 0xd5, 0x7f, 0xc3, 0x26, 0xa6, 0x5f, 0xab,
-	0x4f, 0xf2, 0x0c, 0xe6, 0xdf, 0x3a, 0x7e, 0xa2, 0x8b, 0x7b, 0xe6, 0x51, 0x3b, 0x52, 0xa7, 0x51,
-	0xdb, 0x7d, 0x5d, 0xfd, 0x55, 0x25, 0x8d, 0x9b, 0xa5, 0xe0, 0x7f, 0x17, 0xb7, 0xfe, 0xb1, 0xb3,
-	0x7e, 0x39, 0x1d, 0xf3, 0x7e, 0x21, 0xa6, 0x90, 0x3f, 0x12, 0xf7, 0x63, 0x67, 0xfd, 0x6f, 0xe3,
-	0xb6, 0xbf, 0x85, 0xb5, 0x5b, 0xbf, 0x03, 0xf9, 0x0a, 0xb6, 0xd3, 0x1f, 0x10, 0x2b, 0xd2, 0xbe,
-	0xe4, 0x3e, 0xb3, 0xb1, 0x23, 0xea, 0xce, 0x6a, 0xfa, 0xd6, 0x31, 0x6a, 0x4f, 0xb8, 0xcf, 0xfa,
-	0x4e, 0xc0, 0xda, 0xff, 0xa8, 0xc0, 0xd6, 0x59, 0x41, 0x71, 0x38, 0xd1, 0xab, 0x9e, 0x47, 0xde,
-	0xc1, 0xee, 0x74, 0xc4, 0x8b, 0x89, 0x99, 0x9b, 0x36, 0xb6, 0x6b, 0x85, 0xcf, 0x5f, 0x97, 0x91,
-	0x51, 0x0a, 0x72, 0x87, 0x58, 0x23, 0x78, 0x2b, 0x98, 0xa9, 0xdc, 0xfd, 0x03, 0xec, 0x7d, 0xc4,
-	0xad, 0x98, 0xc9, 0xba, 0xce, 0xe4, 0x17, 0xd3, 0x99, 0xdc, 0x9c, 0x79, 0xa8, 0x62, 0x06, 0xff,
-	0x5a, 0x81, 0xe5, 0xa2, 0x8e, 0xec, 0x41, 0xbd, 0x78, 0x35, 0xec, 0x44, 0x41, 0x9a, 0x88, 0x97,
-	0xd0, 0x34, 0x4a, 0xa1, 0x29, 0x88, 0xd9, 0xe7, 0xd6, 0xa8, 0x37, 0x24, 0x26, 0x25, 0x2a, 0xf9,
-	0x1c, 0xe3, 0xe1, 0x65, 0x64, 0xc8, 0x45, 0x79, 0x8e, 0xf5, 0xc2, 0xcb, 0x28, 0x9d, 0x63, 0xea,
-	0xbb, 0x1d, 0x01, 0xe4, 0x23, 0x21, 0x9b, 0x6a, 0x95, 0xc2, 0x54, 0xb3, 0x60, 0x71, 0xcc, 0x62,
-	0x97, 0x85, 0xd2, 0x8c, 0xd8, 0x74, 0xa9, 0xa8, 0x91, 0x4e, 0x84, 0x1e, 0xb4, 0x7a, 0x41, 0xee,
-	0x03, 0x44, 0x89, 0x74, 0xa3, 0x80, 0xa9, 0xdb, 0xd5, 0x10, 0x81, 0x75, 0x23, 0xe9, 0x79, 0xed,
-	0x7f, 0x55, 0xa0, 0x55, 0xee, 0x10, 0xe4, 0x2f, 0x15, 0x78, 0x14, 0x33, 0xc9, 0x42, 0x9c, 0x0c,
-	0x48, 0x4e, 0xf0, 0xf7, 0xbf, 0x45, 0x11, 0x0c, 0x10, 0x5e, 0xdd, 0xd9, 0x6c, 0x9e, 0xd2, 0x34,
-	0x0c, 0x55, 0x51, 0x0e, 0x27, 0x67, 0x65, 0x2e, 0xa1, 0xd1, 0xf0, 0x30, 0xfe, 0x31, 0xbb, 0xdd,
-	0x11, 0x3c, 0xfe, 0xcf, 0x82, 0xcd, 0xa8, 0xb6, 0x8d, 0x22, 0x46, 0xaa, 0x45, 0x30, 0xbc, 0x2a,
-	0x5c, 0xdf, 0x54, 0x9b, 0xb2, 0x1e, 0xab, 0x0f, 0xc3, 0x6a, 0xf4, 0x02, 0x47, 0x72, 0x80, 0x3c,
-	0x42, 0x07, 0x31, 0xab, 0xb6, 0x0d, 0x1b, 0xb3, 0x6a, 0x96, 0x9c, 0x02, 0xc9, 0x9b, 0xb3, 0x23,
-	0xed, 0x34, 0xe4, 0xdc, 0xc7, 0x5b, 0x53, 0xde, 0x9d, 0x8d, 0xa4, 0xfd, 0xe7, 0x0a, 0xac, 0xa6,
-	0x2c, 0x38, 0x74, 0xc6, 0xe2, 0x3a, 0x92, 0xe4, 0x15, 0xac, 0xa6, 0x2c, 0x36, 0x85, 0x65, 0x05,
-	0x11, 0xb6, 0x5d, 0x42, 0x58, 0x4a, 0x9c, 0xa9, 0x41, 0x71, 0x46, 0xa4, 0x5f, 0xc2, 0x72, 0x01,
-	0x9f, 0x8a, 0x31, 0xcf, 0xdd, 0x05, 0xd0, 0x46, 0x0e, 0x50, 0xd1, 0xfe, 0xd3, 0x0a, 0x40, 0xae,
-	0xbb, 0x45, 0xdf, 0x76, 0x61, 0x29, 0x09, 0xf9, 0x5b, 0x16, 0x0b, 0x9d, 0xec, 0x3a, 0xcd, 0xd6,
-	0x8a, 0x11, 0x14, 0xa9, 0x9d, 0xe6, 0x6a, 0x45, 0x16, 0xf7, 0x10, 0x96, 0xc3, 0x24, 0xb0, 0x0d,
-	0x3a, 0x85, 0x21, 0x6c, 0x8d, 0x30, 0x09, 0x06, 0x46, 0x84, 0xb5, 0xca, 0x43, 0x93, 0xcc, 0x79,
-	0x53, 0xab, 0x3c, 0xd4, 0x29, 0xc7, 0x42, 0x7e, 0x6f, 0x94, 0x0b, 0x69, 0x21, 0xbf, 0xd7, 0xca,
-	0x27, 0xd0, 0x72, 0x93, 0x20, 0xf1, 0x1d, 0xc9, 0xdf, 0x32, 0x5b, 0xb8, 0x8e, 0xcf, 0xac, 0x45,
-	0xb4, 0x59, 0xcd, 0xe5, 0x43, 0x25, 0xfe, 0xbf, 0xb0, 0xaf, 0x87, 0x90, 0xb9, 0xd9, 0x97, 0x2c,
-	0x25, 0x5e, 0x8d, 0x54, 0x76, 0xc2, 0x30, 0x92, 0x60, 0x52, 0xfa, 0x0c, 0x39, 0xac, 0x32, 0x42,
-	0xea, 0x45, 0x57, 0x72, 0xa9, 0x32, 0xfb, 0x19, 0x90, 0x98, 0x8d, 0xa3, 0x58, 0xf2, 0xf0, 0x4a,
-	0x59, 0xa9, 0x82, 0x65, 0xd6, 0x4a, 0x4a, 0xe4, 0x8c, 0xe6, 0x84, 0x31, 0xaa, 0x09, 0x69, 0x3a,
-	0x2a, 0x70, 0xab, 0x28, 0xce, 0x5d, 0x9a, 0xc5, 0x51, 0x71, 0xa4, 0xb5, 0xa9, 0xdb, 0x37, 0xb0,
-	0x77, 0xdb, 0x4d, 0xd8, 0x17, 0x8e, 0xef, 0x84, 0x2e, 0x33, 0xfc, 0xcd, 0x2a, 0xbb, 0x8a, 0x43,
-	0xad, 0x27, 0x2f, 0x60, 0xab, 0xe4, 0x1e, 0x38, 0xdc, 0xbf, 0x88, 0xde, 0x23, 0xad, 0x2b, 0x6f,
-	0x7a, 0xa6, 0x75, 0xe4, 0x37, 0x70, 0x6f, 0xb6, 0x97, 0x1d, 0xbd, 0x0b, 0x59, 0x6c, 0xad, 0xa1,
-	0xef, 0xce, 0x2c, 0xdf, 0x81, 0x32, 0x20, 0x4f, 0x61, 0x9d, 0x87, 0x5c, 0x72, 0xc7, 0xb7, 0x75,
-	0x22, 0x6c, 0xc1, 0x3f, 0x30, 0x8b, 0xa0, 0xdf, 0x9a, 0x51, 0x51, 0xd4, 0x0c, 0xf9, 0x07, 0x36,
-	0x45, 0x49, 0xd7, 0x4b, 0x94, 0x34, 0xe5, 0xb8, 0x1b, 0x05, 0x8e, 0xbb, 0x95, 0xd1, 0xc0, 0x4d,
-	0x0d, 0x94, 0x8c, 0xf6, 0x91, 0x28, 0x91, 0x42, 0x3a, 0xa1, 0xa7, 0x7e, 0x14, 0x71, 0xed, 0xc4,
-	0x4c, 0x33, 0xb3, 0x3a, 0x5d, 0x2b, 0x68, 0x86, 0xa8, 0x50, 0x3d, 0x5a, 0xfd, 0x08, 0xef, 0x78,
-	0xe8, 0x45, 0xef, 0x90, 0x76, 0xd5, 0x69, 0xfd, 0x92, 0xb1, 0x37, 0x28, 0x48, 0x9f, 0x17, 0x88,
-	0x38, 0x2b, 0x7b, 0x5e, 0x18, 0xfe, 0xbb, 0x73, 0xc9, 0xc3, 0xec, 0x25, 0xa2, 0x01, 0x67, 0x87,
-	0x49, 0x70, 0xc1, 0x62, 0x6b, 0x07, 0x6d, 0xb7, 0x8b, 0x06, 0x88, 0xbd, 0x3e, 0xaa, 0x15, 0xff,
-	0x9f, 0xf2, 0xc5, 0xf8, 0xbb, 0xe8, 0xd3, 0x2a, 0x2a, 0x70, 0xa3, 0x57, 0xb0, 0x9a, 0x83, 0x4c,
-	0x48, 0x05, 0x97, 0x3d, 0x7c, 0x9a, 0xe5, 0x0d, 0x87, 0xa6, 0xfa, 0xa1, 0x52, 0xd3, 0x66, 0x3c,
-	0xb5, 0x56, 0x83, 0xeb, 0x32, 0x8a, 0x6f, 0x78, 0x78, 0x65, 0xdd, 0xc3, 0xb7, 0x40, 0xba, 0x54,
-	0x6f, 0xe3, 0x90, 0x31, 0x4f, 0xd8, 0x01, 0xbf, 0xd2, 0x2f, 0x61, 0xeb, 0x3e, 0x5a, 0x34, 0x51,
-	0x7c, 0x96, 0x4a, 0xc9, 0x3e, 0x34, 0x3c, 0x26, 0xdc, 0x98, 0x8f, 0xd1, 0xe8, 0x27, 0xba, 0x64,
-	0x0a, 0x22, 0xb5, 0x49, 0xfa, 0x4c, 0x79, 0x80, 0xda, 0xec, 0x89, 0xf2, 0x0c, 0xd6, 0x55, 0xcd,
-	0x3b, 0xb1, 0xed, 0xb1, 0x30, 0x0a, 0x78, 0xa8, 0x37, 0xda, 0x47, 0x2b, 0xa2, 0x55, 0xc7, 0x05,
-	0x8d, 0x72, 0xf0, 0x98, 0xe0, 0x57, 0xa1, 0x23, 0x99, 0x67, 0xe0, 0xc3, 0x62, 0xeb, 0xa1, 0x76,
-	0xc8, 0x55, 0xd4, 0x68, 0xc8, 0x4b, 0xd8, 0xbe, 0xe5, 0xa0, 0x52, 0x75, 0xc3, 0xac, 0x36, 0x3a,
-	0x6d, 0x96, 0x9d, 0x86, 0x4a, 0x39, 0xfb, 0x1d, 0xf6, 0xe9, 0x1d, 0xef, 0xb0, 0x3d, 0xa8, 0xab,
-	0x16, 0x29, 0xb9, 0x7b, 0x23, 0xac, 0x9f, 0x6a, 0x88, 0x86, 0x49, 0x30, 0x52, 0x6b, 0xa5, 0x54,
-	0x0a, 0x0d, 0xf2, 0x47, 0x5a, 0xa9, 0x04, 0x88, 0xed, 0x5f, 0x42, 0xdd, 0x8d, 0x42, 0xc1, 0x42,
-	0x91, 0x08, 0xeb, 0x71, 0x89, 0x21, 0xf7, 0xa3, 0x38, 0x50, 0x3f, 0x38, 0xf3, 0xce, 0x9d, 0x49,
-	0x94, 0x48, 0x9a, 0xdb, 0x92, 0x5f, 0xc0, 0x52, 0xd6, 0x91, 0x3f, 0xc3, 0x29, 0xb1, 0x91, 0xf9,
-	0x99, 0xbe, 0x8c, 0x63, 0x22, 0xb3, 0x52, 0x3d, 0xa6, 0xf0, 0x7a, 0x9b, 0xc2, 0xe4, 0x01, 0xe2,
-	0x6b, 0x23, 0x7b, 0xc5, 0x15, 0x01, 0x39, 0xe3, 0xd1, 0xf7, 0x64, 0xc6, 0xa3, 0xaf, 0xdd, 0x83,
-	0x56, 0xf9, 0xbc, 0xaa, 0x84, 0xb8, 0xb0, 0x79, 0xf8, 0xd6, 0xf1, 0xcd, 0x3c, 0x5a, 0xa2, 0x75,
-	0x2e, 0x7a, 0x5a, 0xa0, 0x0a, 0x75, 0x8c, 0x86, 0x38, 0xe7, 0xea, 0xd4, 0xac, 0xda, 0x01, 0x34,
-	0x0a, 0x57, 0x28, 0x4c, 0xb3, 0x1a, 0x4e, 0xb3, 0xbc, 0xbe, 0xab, 0x53, 0xf5, 0x9d, 0x31, 0x04,
-	0x3d, 0xc3, 0x0c, 0x43, 0x28, 0xc1, 0xb3, 0x76, 0x0b, 0x9e, 0x9f, 0xbf, 0x48, 0x67, 0x27, 0x8e,
-	0xbb, 0x3a, 0xcc, 0xff, 0xd0, 0x1d, 0xf6, 0x07, 0xad, 0x4f, 0xc8, 0x2a, 0x34, 0x8e, 0x3a, 0xa3,
-	0xee, 0xe9, 0x80, 0xf6, 0x8e, 0x3a, 0xaf, 0x5b, 0x15, 0x02, 0xb0, 0x30, 0x3c, 0xea, 0xbc, 0xee,
-	0xd0, 0x56, 0xf5, 0xf3, 0x7f, 0x56, 0xa0, 0x39, 0x5d, 0x5c, 0x64, 0x0d, 0x56, 0xce, 0x69, 0xd7,
-	0xa6, 0xdd, 0xf3, 0x01, 0x1d, 0xf5, 0xfa, 0xa7, 0xad, 0x4f, 0x88, 0x05, 0x1b, 0xc7, 0xdd, 0x61,
-	0xef, 0xb4, 0xdf, 0x19, 0x75, 0x8f, 0x0b, 0x9a, 0x0a, 0x21, 0xd0, 0x1c, 0x9c, 0x77, 0xfb, 0x05,
-	0x59, 0x95, 0xec, 0xc0, 0xe6, 0x11, 0x1d, 0xbc, 0x39, 0x1e, 0x0e, 0xbe, 0xa7, 0x47, 0xbd, 0xfe,
-	0xa9, 0x7d, 0xdc, 0x1b, 0x9e, 0x7f, 0x3f, 0xea, 0xb6, 0xe6, 0x54, 0xa0, 0xce, 0x9b, 0x4e, 0x4f,
-	0x19, 0xda, 0xfd, 0xee, 0xef, 0x46, 0xf6, 0x9b, 0x5e, 0xff, 0x78, 0xf0, 0xa6, 0x55, 0x53, 0x4e,
-	0x99, 0xe6, 0xa4, 0xd7, 0xef, 0xbc, 0xee, 0xfd, 0xbe, 0x33, 0xea, 0x0d, 0xfa, 0xad, 0x79, 0xb2,
-	0x02, 0x75, 0x23, 0xe9, 0x1e, 0xb7, 0x16, 0x48, 0x03, 0x16, 0x4f, 0x06, 0xf4, 0x3b, 0xb5, 0xd7,
-	0x22, 0xd9, 0x87, 0x7b, 0x79, 0xc0, 0x81, 0x39, 0x86, 0x7d, 0xd6, 0x3b, 0xa5, 0xda, 0x7b, 0x89,
-	0xec, 0xc1, 0x76, 0x1e, 0x78, 0x40, 0xbf, 0x2b, 0x28, 0xeb, 0x17, 0x0b, 0xf8, 0xaf, 0xe0, 0x97,
-	0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xaf, 0x3c, 0x3d, 0x3e, 0x26, 0x14, 0x00, 0x00,
+	proto.RegisterEnum("markets.MarketEventType", MarketEventType_name, MarketEventType_value)
+	proto.RegisterEnum("markets.ArbitrageType", ArbitrageType_name, ArbitrageType_value)
+	proto.RegisterEnum("markets.PredictionMethod", PredictionMethod_name, PredictionMethod_value)
+	proto.RegisterEnum("markets.PredictionConfidence", PredictionConfidence_name, PredictionConfidence_value)
+	proto.RegisterEnum("markets.PriceImpactUnit", PriceImpactUnit_name, PriceImpactUnit_value)
+	proto.RegisterEnum("markets.LiquidityStrategy", LiquidityStrategy_name, LiquidityStrategy_value)
+}
+
+func init() { proto.RegisterFile("markets.proto", fileDescriptor_markets_db299e8d6040dbc2) }
+
+var fileDescriptor_markets_db299e8d6040dbc2 = []byte{
+	// 5270 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x3c, 0xcf, 0x6f, 0x1b, 0xcb,
+	0x79, 0x26, 0x45, 0x51, 0xe4, 0x27, 0x89, 0xa2, 0x46, 0xbf, 0x56, 0x92, 0xe5, 0x27, 0x33, 0xcf,
+	0x2f, 0xb2, 0xf3, 0x2c, 0xc7, 0x7e, 0x89, 0x9b, 0x5f, 0x0f, 0x79, 0x14, 0x49, 0xdb, 0x7c, 0x96,
+	0x44, 0x65, 0x49, 0xdb, 0x49, 0xd3, 0x62, 0xbb, 0xe4, 0x8e, 0xa4, 0x85, 0xc8, 0x5d, 0x66, 0x67,
+	0x29, 0x99, 0x29, 0x02, 0x04, 0x2d, 0x8a, 0x16, 0xe8, 0xa1, 0x68, 0x8e, 0x41, 0x50, 0xf4, 0xdc,
+	0x6b, 0x51, 0xf4, 0xd8, 0x1e, 0xfa, 0x07, 0xf4, 0x50, 0xa4, 0xed, 0xbd, 0x40, 0x91, 0x4b, 0x2f,
+	0xed, 0x31, 0x87, 0xe2, 0x9b, 0x99, 0x5d, 0xce, 0x2e, 0x97, 0x94, 0x1c, 0xbf, 0xb4, 0x37, 0xce,
+	0xf7, 0x6b, 0xbe, 0x99, 0xf9, 0xe6, 0x9b, 0x6f, 0xbe, 0xf9, 0x96, 0xb0, 0xd8, 0x33, 0xbd, 0x0b,
+	0xea, 0xb3, 0xfd, 0xbe, 0xe7, 0xfa, 0x2e, 0x99, 0x93, 0xcd, 0xd2, 0xaf, 0xd3, 0x50, 0x38, 0x12,
+	0xbf, 0x9b, 0x83, 0x5e, 0xcf, 0xf4, 0x86, 0x64, 0x15, 0x66, 0xdb, 0x5d, 0xb7, 0x73, 0xa1, 0xa5,
+	0x76, 0x53, 0x7b, 0x19, 0x5d, 0x34, 0xc8, 0x97, 0x60, 0xd1, 0x77, 0x7d, 0xb3, 0x6b, 0x48, 0x4e,
+	0x2d, 0xcd, 0xb1, 0x0b, 0x1c, 0x28, 0x25, 0x90, 0x13, 0xb8, 0x1d, 0x21, 0x32, 0x3a, 0x66, 0xdf,
+	0xf6, 0xcd, 0xae, 0xfd, 0x63, 0xd3, 0xb7, 0x5d, 0x47, 0x9b, 0xd9, 0x4d, 0xed, 0xcd, 0x3f, 0x29,
+	0xec, 0x07, 0xca, 0x9c, 0x78, 0x76, 0x87, 0xea, 0x5b, 0xaa, 0x8c, 0x4a, 0x84, 0x83, 0xdc, 0x87,
+	0x40, 0x55, 0x2d, 0xb3, 0x3b, 0xb3, 0x37, 0xff, 0x64, 0x29, 0x64, 0x16, 0x0c, 0x7a, 0x80, 0x27,
+	0x5f, 0x86, 0xa5, 0x33, 0xea, 0x50, 0x8f, 0x33, 0x1a, 0xbe, 0xdd, 0xa3, 0xda, 0x2c, 0xd7, 0xb1,
+	0x30, 0x02, 0xb7, 0xec, 0x1e, 0x25, 0x3f, 0x00, 0xad, 0x6b, 0xff, 0x68, 0x60, 0x5b, 0xb6, 0x3f,
+	0x34, 0x7a, 0xd4, 0xf7, 0xec, 0x0e, 0x33, 0x3a, 0xae, 0x73, 0x6a, 0x9f, 0x69, 0x59, 0xae, 0xe1,
+	0x07, 0x61, 0x27, 0x87, 0x01, 0xe1, 0x91, 0xa0, 0xab, 0x70, 0x32, 0x7d, 0xbd, 0x9b, 0x08, 0x27,
+	0xfb, 0xb0, 0xe2, 0x7b, 0xd4, 0xb1, 0x6c, 0xe7, 0x4c, 0xce, 0x81, 0x61, 0x5b, 0x4c, 0x9b, 0xdb,
+	0x9d, 0xd9, 0xcb, 0xeb, 0xcb, 0x01, 0x4a, 0x68, 0x5e, 0xb7, 0x58, 0xe9, 0x1f, 0x53, 0xb0, 0x5c,
+	0x31, 0x7d, 0x7a, 0xe6, 0x7a, 0x36, 0xbd, 0x66, 0x05, 0x12, 0xc6, 0x97, 0x4e, 0x1c, 0xdf, 0xb7,
+	0x01, 0x3a, 0xa1, 0x4c, 0x6d, 0x86, 0x4f, 0xdb, 0x76, 0x38, 0x22, 0xd9, 0xdd, 0xb0, 0xe9, 0x9b,
+	0xbe, 0xcd, 0x7c, 0xbb, 0xc3, 0x74, 0x85, 0x9c, 0x3c, 0x82, 0x8c, 0x6f, 0x9e, 0x05, 0xb3, 0x3d,
+	0x95, 0x8d, 0x13, 0x96, 0xfe, 0x25, 0x0b, 0x64, 0x1c, 0x49, 0x08, 0x64, 0x1c, 0xb3, 0x47, 0xf9,
+	0x10, 0xf2, 0x3a, 0xff, 0xfd, 0xff, 0x65, 0x43, 0x8f, 0x41, 0xf4, 0x60, 0x5c, 0xba, 0xdd, 0x41,
+	0x8f, 0x6a, 0x99, 0x44, 0x09, 0xf3, 0x9c, 0xe6, 0x35, 0x27, 0x21, 0x65, 0x58, 0x53, 0x59, 0x8c,
+	0xae, 0xc9, 0x7c, 0xc3, 0x32, 0x87, 0xda, 0x6c, 0x22, 0x2f, 0x51, 0x78, 0x0f, 0x4d, 0xe6, 0x57,
+	0xcd, 0x21, 0xf9, 0x04, 0x16, 0xdd, 0x3e, 0x75, 0x0c, 0xdb, 0xf1, 0xa9, 0x47, 0x99, 0xaf, 0x65,
+	0x13, 0x59, 0x17, 0x90, 0xa8, 0x2e, 0x69, 0xc8, 0xdf, 0xa4, 0xe0, 0xa1, 0x79, 0x49, 0x3d, 0xf3,
+	0x8c, 0x1a, 0x1e, 0xf5, 0xa9, 0xc3, 0xd7, 0x9a, 0xaf, 0xad, 0xd1, 0x1e, 0x1a, 0x3d, 0xbb, 0xdb,
+	0xb5, 0xa9, 0x7f, 0x4e, 0x3d, 0xc3, 0xf7, 0x4c, 0xa7, 0x73, 0x4e, 0xb9, 0x69, 0xcd, 0x3f, 0xa9,
+	0x4f, 0x59, 0xa7, 0xfd, 0xb2, 0x10, 0xa8, 0x07, 0xf2, 0x74, 0x14, 0x77, 0x30, 0x3c, 0x0a, 0x85,
+	0xb5, 0x84, 0xac, 0x9a, 0xe3, 0x7b, 0x43, 0x7d, 0xcf, 0xbc, 0x21, 0x39, 0xf9, 0x93, 0x14, 0xec,
+	0x46, 0x97, 0xaa, 0x3d, 0x34, 0x3c, 0xda, 0x77, 0x3d, 0x1f, 0xed, 0x9f, 0xf9, 0xa6, 0x4f, 0xb5,
+	0x1c, 0xd7, 0xef, 0xd3, 0x69, 0xfa, 0xb5, 0x94, 0xa5, 0x3b, 0x18, 0xea, 0x81, 0x00, 0xa4, 0x90,
+	0x3a, 0xdd, 0xf6, 0xa7, 0x90, 0x90, 0x7b, 0x50, 0x08, 0x37, 0x1d, 0xeb, 0xb8, 0x1e, 0xd5, 0xf2,
+	0xbb, 0xa9, 0xbd, 0xb4, 0xbe, 0x18, 0x40, 0x9b, 0x08, 0xdc, 0xfa, 0x21, 0x3c, 0x7c, 0xa7, 0x99,
+	0x20, 0x45, 0x98, 0xb9, 0xa0, 0x43, 0xb9, 0x09, 0xf1, 0x27, 0x6e, 0xcc, 0x4b, 0xb3, 0x3b, 0x10,
+	0x1b, 0x2f, 0xad, 0x8b, 0xc6, 0xb7, 0xd2, 0xdf, 0x48, 0x6d, 0x35, 0xe0, 0xee, 0xb5, 0xc3, 0x50,
+	0x05, 0xe6, 0x13, 0x04, 0x66, 0x14, 0x81, 0xa5, 0x7f, 0xcd, 0xc2, 0x7a, 0xb2, 0xf3, 0x21, 0x8f,
+	0x60, 0x65, 0xdc, 0x10, 0x98, 0x96, 0xda, 0x9d, 0xd9, 0xcb, 0xe8, 0xa4, 0x17, 0x1f, 0x0c, 0x23,
+	0x77, 0x61, 0x61, 0xc0, 0xac, 0x11, 0x65, 0x9a, 0x53, 0xce, 0x0f, 0x98, 0x15, 0x92, 0xf4, 0x61,
+	0x23, 0x40, 0x73, 0x43, 0x13, 0xbe, 0xcb, 0x1f, 0xf6, 0xa9, 0x74, 0x20, 0xdf, 0xbc, 0xc6, 0x25,
+	0xee, 0x07, 0xa2, 0x0e, 0x86, 0x62, 0x0e, 0x5a, 0xc3, 0xbe, 0x5c, 0xbd, 0x55, 0x3f, 0x01, 0x45,
+	0x36, 0x60, 0x8e, 0xfa, 0xe7, 0xc6, 0x80, 0x59, 0x7c, 0x53, 0xa5, 0xf5, 0x2c, 0xf5, 0xcf, 0x5f,
+	0x31, 0x8b, 0x5c, 0xc2, 0x26, 0x6a, 0x9b, 0x6c, 0xee, 0x59, 0xae, 0xcc, 0xb7, 0xaf, 0x53, 0xe6,
+	0x15, 0xb3, 0x26, 0x1a, 0xf8, 0xfa, 0x20, 0x11, 0x89, 0xfd, 0x2a, 0x1d, 0xb6, 0x87, 0x86, 0x32,
+	0x67, 0xda, 0xdc, 0xcd, 0xfa, 0x1d, 0x49, 0x3d, 0x18, 0xbe, 0x62, 0x56, 0xb4, 0xdf, 0x5e, 0x22,
+	0x12, 0x3d, 0xe5, 0x29, 0xa5, 0x4c, 0xcb, 0xed, 0xa6, 0xf6, 0x72, 0x3a, 0xff, 0x4d, 0x3e, 0x84,
+	0xc2, 0x99, 0xc9, 0x8c, 0x3e, 0xba, 0x08, 0xe3, 0xec, 0x8a, 0xda, 0xd2, 0xa4, 0x17, 0xce, 0x4c,
+	0xc6, 0xfd, 0xc6, 0xf3, 0x2b, 0x6a, 0x93, 0x5d, 0x58, 0xe0, 0x54, 0xd4, 0x33, 0x4e, 0xed, 0x6e,
+	0x57, 0x03, 0x6e, 0x44, 0x80, 0x34, 0xd4, 0x7b, 0x66, 0x77, 0xbb, 0x5b, 0x1d, 0xd8, 0x9c, 0xb8,
+	0x2e, 0x09, 0xe6, 0xf8, 0x55, 0xd5, 0x1c, 0xe7, 0x9f, 0x6c, 0x8d, 0x0f, 0x37, 0x90, 0xa6, 0xda,
+	0x7e, 0x1d, 0xb6, 0xa7, 0xcc, 0xf7, 0x3b, 0x6d, 0xa3, 0x3a, 0x6c, 0x4f, 0x99, 0xc2, 0x77, 0x11,
+	0xf5, 0x79, 0x26, 0x97, 0x29, 0xce, 0xea, 0xcb, 0x8c, 0x76, 0xbb, 0xe8, 0x18, 0x6c, 0xa7, 0xe3,
+	0xd1, 0x1e, 0x75, 0xfc, 0xd2, 0x19, 0x2c, 0x8f, 0x0d, 0xe7, 0xb7, 0xb1, 0xa7, 0x4a, 0x9f, 0xc2,
+	0x2c, 0x5f, 0x2b, 0x54, 0x9b, 0xfa, 0xe7, 0x5c, 0xed, 0xb4, 0x8e, 0x3f, 0x11, 0x82, 0x86, 0x2f,
+	0x94, 0xc6, 0x9f, 0x08, 0x69, 0xfb, 0x1d, 0x7e, 0xba, 0xa5, 0x75, 0xfc, 0x59, 0xfa, 0xe5, 0x12,
+	0x64, 0xc5, 0x92, 0x91, 0x02, 0xa4, 0x6d, 0x4b, 0x2e, 0x54, 0xda, 0xb6, 0xc8, 0xd7, 0x60, 0x5e,
+	0xdd, 0xa1, 0x28, 0xa6, 0xf0, 0x64, 0x25, 0x16, 0x19, 0xe1, 0x42, 0xeb, 0xd0, 0x0b, 0x7f, 0x87,
+	0x47, 0xf2, 0x4c, 0xf4, 0x48, 0xee, 0xb8, 0x3d, 0x9c, 0x17, 0xa3, 0xe3, 0x0e, 0x1c, 0x9f, 0x1f,
+	0x8e, 0x8b, 0xfa, 0x82, 0x04, 0x56, 0x10, 0x46, 0x2a, 0xb0, 0x26, 0xbb, 0x8b, 0x9d, 0xc5, 0xc9,
+	0xa7, 0xe1, 0xaa, 0x68, 0xc6, 0x4e, 0xe1, 0x4d, 0xc8, 0x51, 0xc7, 0x32, 0x2c, 0x3c, 0x14, 0xb2,
+	0x7c, 0x01, 0xe7, 0xa8, 0x63, 0x55, 0xd1, 0x81, 0x7f, 0x1d, 0xe6, 0xfb, 0x1e, 0xb5, 0xec, 0x0e,
+	0x12, 0x32, 0xb9, 0xd7, 0x56, 0x14, 0xa9, 0x01, 0x4e, 0x57, 0xe9, 0xc8, 0x3a, 0x64, 0xcd, 0x81,
+	0x7f, 0xee, 0x7a, 0x7c, 0xeb, 0xe4, 0x75, 0xd9, 0xe2, 0x63, 0xf2, 0xa8, 0x12, 0x26, 0xe5, 0x45,
+	0x98, 0x11, 0x00, 0x79, 0x90, 0x74, 0x0f, 0x0a, 0x21, 0x91, 0x08, 0xb6, 0xc4, 0xee, 0x09, 0x59,
+	0x0f, 0x10, 0x48, 0xbe, 0x02, 0xcb, 0x1e, 0x65, 0x6e, 0x77, 0xc0, 0x09, 0x99, 0x3b, 0xf0, 0x3a,
+	0x54, 0x9b, 0xe7, 0xdd, 0x15, 0x47, 0x88, 0x26, 0x87, 0x93, 0xdb, 0x30, 0x67, 0x51, 0xdf, 0xb4,
+	0xbb, 0x4c, 0x5b, 0x40, 0x92, 0x83, 0xb4, 0x96, 0xd2, 0x03, 0x10, 0x4e, 0x3f, 0x8f, 0xac, 0x16,
+	0x79, 0x30, 0xc8, 0x7f, 0x93, 0x0f, 0x60, 0xde, 0x66, 0xc6, 0x29, 0x35, 0xfd, 0x81, 0x47, 0x2d,
+	0xad, 0xc0, 0x5d, 0x00, 0xd8, 0xec, 0x99, 0x84, 0x90, 0x2d, 0xc8, 0xc9, 0xe0, 0x6c, 0xa8, 0x2d,
+	0xf1, 0x6e, 0xc3, 0x36, 0xf9, 0x08, 0x96, 0x78, 0x5c, 0xe2, 0x7b, 0xa6, 0x45, 0xc5, 0x48, 0x8b,
+	0x62, 0x0c, 0x08, 0x6e, 0x21, 0x94, 0x0f, 0xf5, 0x5b, 0x90, 0x6f, 0x53, 0xe6, 0x1b, 0x6d, 0x0c,
+	0x45, 0x97, 0xf9, 0xe4, 0xee, 0xc4, 0x6c, 0x65, 0xff, 0x80, 0x32, 0xff, 0xc0, 0xb6, 0x98, 0x70,
+	0x55, 0xb9, 0xb6, 0x6c, 0x86, 0xbc, 0x26, 0xbb, 0x60, 0x1a, 0x99, 0xcc, 0x5b, 0x66, 0x17, 0x2a,
+	0x2f, 0x36, 0xc9, 0x47, 0x90, 0x95, 0x11, 0xd7, 0x4a, 0xa2, 0x9d, 0x48, 0x2c, 0x79, 0x08, 0x19,
+	0xae, 0xda, 0x2a, 0x17, 0xbf, 0x39, 0x26, 0x3e, 0x54, 0x8b, 0x93, 0x21, 0x39, 0xd7, 0x66, 0x2d,
+	0x99, 0x7c, 0xa4, 0x09, 0x27, 0x23, 0xcf, 0x60, 0x79, 0x2c, 0xda, 0xd7, 0xd6, 0x77, 0x53, 0x11,
+	0xde, 0xb8, 0x3b, 0xd7, 0x8b, 0xf1, 0x00, 0x9f, 0x7c, 0x0e, 0x2b, 0x72, 0x13, 0x58, 0xa6, 0x6f,
+	0x4a, 0x53, 0x60, 0xda, 0x46, 0xcc, 0x53, 0x0a, 0x2d, 0xaa, 0xa6, 0x6f, 0x0a, 0xa3, 0x60, 0xfa,
+	0x72, 0x2f, 0x0e, 0x22, 0x4f, 0x61, 0x29, 0x1e, 0x58, 0x6a, 0x89, 0x53, 0xb4, 0x78, 0x19, 0x89,
+	0x29, 0xbf, 0x01, 0x45, 0x95, 0xef, 0x8a, 0xd2, 0x0b, 0x6d, 0x33, 0x91, 0xb1, 0x30, 0x62, 0x7c,
+	0x43, 0xe9, 0x05, 0xe9, 0xc2, 0x36, 0x9a, 0x09, 0x7a, 0x42, 0xb3, 0xe3, 0xdb, 0x97, 0x38, 0x19,
+	0xed, 0xa1, 0xe1, 0x0e, 0xfc, 0x8e, 0xdb, 0xa3, 0xda, 0x16, 0x9f, 0xcb, 0x87, 0xf1, 0xb9, 0x6c,
+	0x09, 0x96, 0xb2, 0xe4, 0x38, 0x18, 0x36, 0x04, 0xbd, 0x98, 0x5f, 0xcd, 0x9f, 0x80, 0x4e, 0x88,
+	0xc8, 0xb6, 0x13, 0x22, 0x32, 0xd2, 0x87, 0x1d, 0xd7, 0xb3, 0xf0, 0xb0, 0x75, 0xdd, 0x8b, 0xf0,
+	0x26, 0xa6, 0xa8, 0x75, 0x9b, 0xab, 0xb5, 0x1f, 0x57, 0xab, 0x81, 0x4c, 0x07, 0xae, 0x7b, 0x21,
+	0xd7, 0x26, 0xa6, 0xd7, 0xa6, 0x3b, 0x09, 0x4f, 0x6e, 0x43, 0xde, 0xbd, 0xa4, 0x9e, 0xe7, 0x0e,
+	0x1c, 0x4b, 0xdb, 0xe1, 0x3a, 0x8d, 0x00, 0x78, 0xc3, 0xb2, 0x9d, 0x4b, 0xb3, 0x6b, 0x5b, 0x78,
+	0xa6, 0x76, 0xa8, 0xe3, 0x6b, 0x77, 0x38, 0x4d, 0x41, 0x82, 0x4f, 0x04, 0x74, 0xeb, 0x35, 0x2c,
+	0x46, 0x36, 0x4c, 0xc2, 0xc1, 0xf4, 0x28, 0x7a, 0x94, 0x26, 0x98, 0x5a, 0xd9, 0x17, 0x4b, 0xa5,
+	0x1c, 0x7f, 0x52, 0x6e, 0x68, 0xc2, 0x5f, 0x9c, 0xdc, 0xfc, 0x34, 0x5d, 0x3f, 0x89, 0xca, 0xdc,
+	0x51, 0x64, 0x32, 0xff, 0x1a, 0xb9, 0xd3, 0x74, 0xfd, 0x8d, 0xe5, 0x76, 0x61, 0x67, 0xaa, 0xe9,
+	0x25, 0xf4, 0xf5, 0xf5, 0x68, 0x5f, 0xa3, 0x1b, 0xbc, 0xe4, 0x8b, 0xc9, 0x53, 0x7b, 0x73, 0xe0,
+	0xce, 0x74, 0x8b, 0x4a, 0xe8, 0xee, 0x69, 0xb4, 0xbb, 0xdd, 0x78, 0x77, 0x71, 0x81, 0x6a, 0x68,
+	0xff, 0x5f, 0x69, 0xd8, 0x98, 0x40, 0x46, 0xb6, 0x21, 0xef, 0x5f, 0xb9, 0x06, 0xb3, 0x2d, 0x2a,
+	0x0e, 0xfc, 0x9c, 0x9e, 0xf3, 0xaf, 0xdc, 0x26, 0xb6, 0x11, 0xd9, 0x43, 0xdb, 0xc4, 0xe9, 0x92,
+	0xb1, 0x43, 0xae, 0x67, 0x5b, 0x22, 0xc8, 0x58, 0x87, 0x2c, 0xeb, 0x7b, 0xd4, 0xb4, 0x64, 0x0c,
+	0x21, 0x5b, 0x68, 0xd4, 0x1e, 0xed, 0x9a, 0xbe, 0x7d, 0x49, 0x0d, 0x49, 0x90, 0x11, 0x46, 0x1d,
+	0x80, 0x9b, 0x82, 0x70, 0x00, 0x9b, 0x16, 0xed, 0xfb, 0xe7, 0xb8, 0x01, 0xa5, 0xf9, 0x1b, 0xa7,
+	0x9e, 0xdb, 0x33, 0x7a, 0x36, 0x86, 0xe8, 0xd1, 0xf8, 0x77, 0x82, 0xfe, 0xfb, 0x55, 0x94, 0x70,
+	0x30, 0x94, 0x1b, 0xe5, 0x99, 0xe7, 0xf6, 0x8e, 0x6c, 0x4b, 0x6c, 0xcb, 0x35, 0x2b, 0x09, 0xb7,
+	0x65, 0xc2, 0xd6, 0x64, 0x26, 0x75, 0xe6, 0x17, 0xc5, 0xcc, 0x3f, 0x8c, 0xce, 0xfc, 0xc6, 0x48,
+	0xa5, 0x40, 0x17, 0x2e, 0x4e, 0x9d, 0xf0, 0x3f, 0x4b, 0x41, 0x21, 0x8a, 0x25, 0x3b, 0x00, 0x6d,
+	0xdb, 0x32, 0xd8, 0xb9, 0xe9, 0xf1, 0x30, 0x8f, 0x7b, 0x82, 0xb6, 0x6d, 0x35, 0x39, 0x00, 0xd1,
+	0x26, 0xbb, 0x08, 0xd0, 0x62, 0xaa, 0xf3, 0x26, 0xbb, 0x90, 0xe8, 0x6d, 0x40, 0x5a, 0x43, 0xe8,
+	0x21, 0xa6, 0x3b, 0xd7, 0xb6, 0xad, 0xd7, 0xd8, 0x46, 0x24, 0xf2, 0x0a, 0xa4, 0x98, 0xea, 0x9c,
+	0xc9, 0x2e, 0x38, 0xb2, 0xf4, 0x97, 0x69, 0x58, 0x4f, 0xb6, 0xc8, 0xa4, 0x43, 0x21, 0xf5, 0x9b,
+	0x1e, 0x0a, 0xe9, 0x1b, 0x1d, 0x0a, 0x3b, 0x00, 0x9c, 0x45, 0x18, 0x94, 0x18, 0x47, 0x1e, 0x21,
+	0xc2, 0xa2, 0x1e, 0xc3, 0x1a, 0xc7, 0x18, 0x9d, 0x73, 0xd3, 0x39, 0x53, 0xd4, 0x12, 0x83, 0x22,
+	0x1c, 0x59, 0xe1, 0xb8, 0x51, 0xd2, 0x63, 0x7d, 0x9c, 0x85, 0x6b, 0x24, 0xee, 0x78, 0x2b, 0x31,
+	0x1e, 0x54, 0xa3, 0xf4, 0x39, 0x2c, 0x8f, 0x9d, 0x9a, 0xe4, 0xeb, 0xb0, 0x11, 0x1c, 0xb7, 0x3c,
+	0x7e, 0xc2, 0x1b, 0x0e, 0x35, 0x94, 0x94, 0x92, 0x8c, 0x32, 0xab, 0x1c, 0xfb, 0xcc, 0xee, 0xd2,
+	0x63, 0xb3, 0x47, 0x4b, 0xff, 0x9d, 0x82, 0xf5, 0x23, 0x05, 0x11, 0xdc, 0x7a, 0xea, 0x16, 0xb9,
+	0x82, 0xad, 0xa8, 0xc4, 0xd1, 0x3d, 0x97, 0x07, 0xd7, 0x51, 0x03, 0x4f, 0x16, 0x32, 0x01, 0x1c,
+	0x5c, 0xf0, 0x12, 0x91, 0x5b, 0x7f, 0x00, 0xdb, 0x53, 0xd8, 0x12, 0xae, 0x61, 0x5f, 0x89, 0x9a,
+	0xf8, 0x5a, 0xa2, 0x52, 0xaa, 0x81, 0xff, 0x73, 0x06, 0x16, 0x54, 0x1c, 0xf7, 0x14, 0xca, 0xd0,
+	0x78, 0xdc, 0xd8, 0x0b, 0x26, 0xe2, 0x29, 0x14, 0x24, 0x92, 0x89, 0x84, 0xa3, 0xec, 0x67, 0x2c,
+	0xb5, 0x2a, 0x93, 0xc6, 0x41, 0x5a, 0x72, 0x74, 0xeb, 0xb0, 0x9d, 0x53, 0x57, 0x26, 0xe2, 0xe2,
+	0xb7, 0x8e, 0xba, 0x73, 0xea, 0x06, 0xb7, 0x0e, 0xfc, 0x4d, 0x8e, 0x60, 0x55, 0x39, 0xe4, 0x4d,
+	0xc7, 0xec, 0x0e, 0x7d, 0x0c, 0xc1, 0x44, 0x16, 0x6e, 0x7b, 0x7c, 0xfb, 0x96, 0x03, 0x12, 0x9d,
+	0xb8, 0x63, 0x30, 0xf2, 0x12, 0x56, 0x46, 0x77, 0x00, 0xe3, 0xd2, 0xf4, 0x6c, 0xd3, 0xf1, 0x99,
+	0xf4, 0x4f, 0x5b, 0x09, 0x77, 0x86, 0xd7, 0x82, 0x04, 0xcd, 0x35, 0x06, 0x62, 0xe4, 0x10, 0x56,
+	0x58, 0xc7, 0xec, 0x9a, 0x9e, 0x61, 0xd9, 0xcc, 0xf7, 0xec, 0x36, 0x8f, 0xe6, 0xb5, 0x6c, 0x4c,
+	0xb5, 0x26, 0xa7, 0xa9, 0x2a, 0x24, 0x3a, 0x61, 0x63, 0x30, 0x72, 0x0a, 0x1b, 0xc2, 0xf8, 0xed,
+	0x5e, 0xdf, 0xec, 0xf8, 0x6a, 0x20, 0x23, 0xae, 0x34, 0x8f, 0x12, 0x17, 0x52, 0x6c, 0xce, 0x3a,
+	0xe7, 0x89, 0x45, 0x32, 0xab, 0xfd, 0x04, 0x14, 0x5e, 0xea, 0x27, 0xb2, 0x24, 0x1c, 0x55, 0x13,
+	0x2f, 0xf5, 0x8a, 0x90, 0xca, 0xc0, 0xbb, 0x8c, 0x5c, 0xea, 0x4b, 0x2e, 0x2c, 0x8f, 0xe1, 0xc9,
+	0x57, 0x60, 0xa6, 0x3d, 0x18, 0xca, 0xbd, 0xb2, 0x99, 0x24, 0xe8, 0xc4, 0xb5, 0x1d, 0x5f, 0x47,
+	0x2a, 0x8c, 0xd3, 0xf1, 0xf2, 0xad, 0xa5, 0xaf, 0xa3, 0xe6, 0x64, 0xa5, 0x5f, 0xa6, 0xa0, 0x18,
+	0x47, 0xe1, 0x9d, 0x89, 0xd9, 0x3f, 0xa6, 0xd2, 0x41, 0xf3, 0xdf, 0xe4, 0x63, 0xc8, 0x0c, 0x1c,
+	0xdb, 0x97, 0xb7, 0x5e, 0x2d, 0x49, 0xee, 0x2b, 0xc7, 0xf6, 0x75, 0x4e, 0xc5, 0x8f, 0x45, 0xe1,
+	0xc5, 0x83, 0x63, 0x91, 0xb7, 0xf0, 0x92, 0x18, 0x24, 0x5a, 0x85, 0xfb, 0x13, 0x4e, 0x6d, 0x41,
+	0x02, 0x85, 0x07, 0xbc, 0xc7, 0x77, 0xca, 0x99, 0xed, 0x98, 0x5d, 0x49, 0x25, 0xdc, 0xd8, 0x62,
+	0x00, 0x15, 0x64, 0xb7, 0x21, 0x4f, 0xdf, 0x9e, 0x9b, 0x03, 0xe6, 0x53, 0x8b, 0x1b, 0x4f, 0x4e,
+	0x1f, 0x01, 0x4a, 0xff, 0x99, 0x06, 0x32, 0x6e, 0x41, 0x98, 0x71, 0x08, 0x6c, 0x8f, 0x3a, 0x6e,
+	0xcf, 0x76, 0xc4, 0x95, 0x5a, 0x6c, 0xd6, 0xc0, 0xbc, 0x14, 0x8c, 0x38, 0xfd, 0x9d, 0xf8, 0xe9,
+	0xef, 0x08, 0x15, 0x10, 0x69, 0xbe, 0x8d, 0x78, 0xf2, 0x5c, 0xcf, 0x7c, 0x2b, 0x90, 0x6d, 0x58,
+	0xe1, 0x0b, 0xab, 0x9c, 0xec, 0x76, 0x97, 0xca, 0x14, 0xff, 0x93, 0x29, 0x66, 0xbe, 0xcf, 0x0f,
+	0xad, 0xf0, 0x64, 0xb6, 0xbb, 0xd2, 0x2e, 0x97, 0x2f, 0xe3, 0x70, 0xf2, 0x1d, 0xc8, 0x9f, 0xdb,
+	0xcc, 0x77, 0xcf, 0x3c, 0xb3, 0x27, 0x77, 0xe3, 0x9d, 0x29, 0x92, 0x0f, 0x6c, 0x47, 0x1f, 0x31,
+	0x6c, 0x55, 0x61, 0x3d, 0xb9, 0xab, 0x84, 0x00, 0x60, 0x62, 0xca, 0xa7, 0x44, 0x61, 0x2d, 0xb1,
+	0x27, 0x64, 0xe9, 0xba, 0x57, 0xd4, 0x93, 0x76, 0x24, 0x1a, 0x08, 0x1d, 0xf4, 0xfb, 0xd4, 0x0b,
+	0x04, 0xf1, 0x06, 0xd9, 0xc5, 0x64, 0x84, 0xdb, 0x36, 0xdb, 0x76, 0xd7, 0xf6, 0x87, 0x72, 0x2e,
+	0x55, 0x50, 0xe9, 0x27, 0xb8, 0x35, 0x62, 0xbe, 0x84, 0x3c, 0x86, 0x6c, 0x8f, 0xfa, 0xe7, 0xae,
+	0x70, 0xb7, 0x85, 0x88, 0xbd, 0x07, 0xb4, 0x47, 0x9c, 0x40, 0x97, 0x84, 0xf1, 0xb4, 0x47, 0xfa,
+	0x66, 0x69, 0x8f, 0xd2, 0xaf, 0x52, 0x40, 0xc6, 0x9d, 0x25, 0xf9, 0x2a, 0x64, 0x05, 0xa7, 0x8c,
+	0x1a, 0xb4, 0xa8, 0x67, 0x55, 0xde, 0x6d, 0x24, 0x1d, 0xa9, 0x03, 0x28, 0x2e, 0x4a, 0x74, 0xff,
+	0x60, 0x8a, 0x3f, 0xde, 0x8f, 0x79, 0xa7, 0x7c, 0x3b, 0x74, 0x49, 0xaf, 0xa1, 0x70, 0xad, 0x1f,
+	0xda, 0x8f, 0xfa, 0xa1, 0xc9, 0xfa, 0x29, 0x2b, 0xfa, 0x1f, 0x69, 0x58, 0x8a, 0xa1, 0x31, 0x67,
+	0xc2, 0x1f, 0x56, 0xf8, 0xe1, 0xc0, 0x64, 0x0f, 0x80, 0x20, 0x4e, 0xc9, 0x1f, 0x02, 0xd1, 0x9d,
+	0xdb, 0x4e, 0xc7, 0x37, 0x7a, 0xe6, 0x05, 0x12, 0xc9, 0x87, 0xb2, 0x00, 0x7c, 0xc4, 0xa1, 0x98,
+	0x40, 0xf1, 0xdd, 0xbe, 0xa0, 0x11, 0xb1, 0x9e, 0x5c, 0xee, 0x45, 0xdf, 0xed, 0x73, 0x1a, 0x1e,
+	0xef, 0x91, 0x6f, 0xc1, 0xa6, 0xa0, 0xe9, 0xb8, 0x0e, 0x1a, 0xa7, 0x7c, 0x82, 0xb3, 0x1d, 0x8b,
+	0xbe, 0x95, 0x7e, 0x63, 0x83, 0x13, 0x54, 0x54, 0x7c, 0x1d, 0xd1, 0x64, 0x0f, 0x8a, 0x3d, 0x6a,
+	0xd9, 0xa6, 0xd4, 0xd7, 0x30, 0xcf, 0xc2, 0x67, 0x49, 0x01, 0xe7, 0x4a, 0x97, 0xcf, 0x28, 0xaa,
+	0x8d, 0x59, 0x5c, 0x6a, 0x19, 0xa7, 0x9e, 0xd9, 0x09, 0x0f, 0xa2, 0xb4, 0x5e, 0x10, 0xe0, 0x67,
+	0x12, 0x8a, 0x84, 0xbe, 0x7b, 0x41, 0x1d, 0x66, 0x50, 0xd6, 0xf1, 0xdc, 0x2b, 0x6a, 0x69, 0x73,
+	0x82, 0x50, 0x80, 0x6b, 0x12, 0x8a, 0x84, 0xc2, 0xdb, 0x8d, 0x08, 0x73, 0x82, 0x50, 0x80, 0x03,
+	0xc2, 0xd2, 0xcf, 0x67, 0x00, 0x46, 0xe6, 0x96, 0xf8, 0x76, 0xa7, 0xc1, 0x5c, 0x70, 0x27, 0x16,
+	0xdb, 0x25, 0x68, 0x8e, 0xf6, 0xe3, 0x8c, 0xb2, 0x1f, 0x31, 0xb6, 0x94, 0x96, 0x85, 0x21, 0x48,
+	0x86, 0x8f, 0x38, 0x2f, 0x21, 0x75, 0x4b, 0xd9, 0x2e, 0xb3, 0x37, 0xdd, 0x2e, 0x8f, 0x61, 0xb5,
+	0xef, 0xb9, 0xfc, 0x55, 0xc5, 0xe5, 0x0e, 0x59, 0xaa, 0x93, 0x0d, 0x22, 0xcb, 0x11, 0x4e, 0x3a,
+	0x11, 0x74, 0xf2, 0x7d, 0xdc, 0xea, 0x21, 0xad, 0x98, 0xa7, 0x05, 0x0e, 0x0c, 0x88, 0x3e, 0x80,
+	0x79, 0xee, 0x0f, 0x8c, 0x36, 0xcf, 0x0a, 0x88, 0x19, 0x02, 0x0e, 0x3a, 0x40, 0x08, 0x12, 0x70,
+	0xd7, 0x20, 0x09, 0x44, 0x26, 0x1e, 0x38, 0x48, 0x10, 0x7c, 0x0a, 0xc0, 0x9f, 0x8f, 0x2d, 0xea,
+	0x74, 0x28, 0xcf, 0x23, 0x16, 0x9e, 0xec, 0x24, 0x0c, 0xa8, 0x12, 0x12, 0xe9, 0x0a, 0x03, 0x4e,
+	0x95, 0xcd, 0x0c, 0x99, 0x62, 0xe0, 0xc9, 0xc5, 0x9c, 0x9e, 0xb7, 0x59, 0x5d, 0x00, 0x4a, 0xff,
+	0xb3, 0x00, 0xc5, 0x78, 0x7e, 0x8a, 0xfc, 0x2c, 0x05, 0xf7, 0x6e, 0xf6, 0x40, 0x28, 0x0e, 0xeb,
+	0xcf, 0x26, 0xa6, 0xba, 0xf6, 0x6f, 0xf8, 0x2e, 0x78, 0xd7, 0xbb, 0x8e, 0x8e, 0xfc, 0x04, 0xee,
+	0x24, 0xe8, 0xa4, 0x3e, 0xa3, 0xa4, 0xaf, 0x79, 0x4b, 0x1a, 0x53, 0x26, 0xfe, 0x88, 0xb2, 0xe5,
+	0x4d, 0x24, 0x20, 0x67, 0xb0, 0x8e, 0x9b, 0x6f, 0x68, 0x74, 0x5c, 0xe6, 0x47, 0xf2, 0x48, 0x33,
+	0xb1, 0x93, 0x6e, 0xac, 0x5b, 0x2e, 0xbc, 0x82, 0x6c, 0x31, 0x1f, 0xb7, 0x42, 0xc7, 0x31, 0xe4,
+	0x17, 0x29, 0xb8, 0xef, 0x50, 0xff, 0x86, 0x2f, 0xb4, 0xe2, 0x98, 0xad, 0x4c, 0xee, 0xfc, 0x98,
+	0xfa, 0x37, 0x5c, 0x83, 0x0f, 0x9d, 0x1b, 0x90, 0x92, 0x3f, 0x4d, 0x41, 0x69, 0x82, 0x7a, 0xea,
+	0x5a, 0x88, 0x43, 0xfa, 0x3b, 0xef, 0xa4, 0x57, 0x7c, 0x39, 0x76, 0x9c, 0x69, 0x34, 0x18, 0x3f,
+	0x31, 0xea, 0xfb, 0x5d, 0xfe, 0xf0, 0x62, 0x9c, 0x52, 0x2a, 0x37, 0xeb, 0xe2, 0x08, 0xfa, 0x8c,
+	0x52, 0xf2, 0x17, 0x29, 0xf8, 0x90, 0xbe, 0xa5, 0x1d, 0x91, 0x64, 0xc7, 0xd5, 0x9b, 0xfa, 0xd8,
+	0xfd, 0xdd, 0x29, 0xeb, 0x18, 0x48, 0xc1, 0x15, 0x9b, 0x38, 0x8d, 0xbb, 0xf4, 0x1a, 0x32, 0xf2,
+	0x63, 0xd8, 0x19, 0x57, 0x48, 0x9d, 0x3c, 0xf1, 0xac, 0xfd, 0x8d, 0x1b, 0x6b, 0x12, 0x9f, 0xb8,
+	0x4d, 0x3a, 0x09, 0xbf, 0xd5, 0x82, 0x8f, 0x7e, 0x0b, 0x0f, 0xd4, 0x47, 0xf0, 0xc1, 0x35, 0x8b,
+	0xf9, 0x4e, 0xe2, 0xda, 0xa0, 0x4d, 0xda, 0x33, 0xef, 0x72, 0x05, 0x51, 0xf9, 0xb8, 0x28, 0xb5,
+	0x8f, 0x37, 0x70, 0xff, 0xc6, 0x5b, 0xe3, 0x9d, 0x94, 0x3f, 0x81, 0xd2, 0xf5, 0xb6, 0xfd, 0x4e,
+	0x12, 0x2f, 0xe0, 0xde, 0x8d, 0x4c, 0x2f, 0x41, 0xe8, 0xc7, 0xd1, 0xb9, 0x59, 0x0f, 0xe7, 0x26,
+	0x22, 0x50, 0xed, 0xcc, 0x82, 0x3b, 0xd3, 0xad, 0xeb, 0x8b, 0xe8, 0xa5, 0xf4, 0xd3, 0x14, 0x2c,
+	0x46, 0x90, 0x38, 0x7c, 0x8c, 0x44, 0x82, 0x90, 0x4b, 0x34, 0xf0, 0x1d, 0x1f, 0x1f, 0xa1, 0xf1,
+	0x81, 0x53, 0x4c, 0x4b, 0xf6, 0xcc, 0x64, 0x35, 0xff, 0x3c, 0x40, 0xe0, 0x3b, 0xe7, 0x4c, 0x88,
+	0xc0, 0x07, 0x7e, 0x9e, 0x91, 0x8c, 0xb8, 0xa6, 0x51, 0x46, 0x52, 0x5d, 0x92, 0xd2, 0xdf, 0x66,
+	0x61, 0x79, 0xcc, 0x42, 0xc8, 0x8f, 0x60, 0xb3, 0x3d, 0x18, 0x4e, 0x3d, 0xed, 0x9e, 0x4e, 0x36,
+	0xb0, 0xfd, 0x83, 0xc1, 0x70, 0x72, 0x69, 0x40, 0x3b, 0x11, 0x49, 0xae, 0x60, 0x9b, 0x9d, 0xbb,
+	0xde, 0x24, 0xb7, 0x94, 0x8e, 0x39, 0x83, 0xf1, 0x4e, 0x9b, 0xc8, 0x3d, 0xb1, 0x5b, 0x8d, 0x4d,
+	0x40, 0x93, 0xdf, 0x03, 0x22, 0xc7, 0xaa, 0x3a, 0x9f, 0x99, 0x58, 0x36, 0x61, 0xc2, 0x20, 0xe3,
+	0x3e, 0x67, 0xa9, 0x1d, 0x85, 0x92, 0x36, 0xac, 0x86, 0xc3, 0x52, 0xe5, 0x8b, 0x13, 0xeb, 0xf1,
+	0xf5, 0xe3, 0x89, 0xf7, 0xb0, 0xcc, 0xe2, 0xf0, 0xad, 0xdf, 0x87, 0xed, 0x29, 0x33, 0x9e, 0x60,
+	0xa9, 0x7b, 0x51, 0x4b, 0x25, 0x23, 0x4b, 0x0d, 0xba, 0x57, 0xf7, 0x82, 0x01, 0x3b, 0x53, 0xe7,
+	0xf6, 0xbd, 0x3b, 0x78, 0x0d, 0xab, 0x49, 0x93, 0xf9, 0xde, 0x72, 0xbf, 0x0f, 0xeb, 0xc9, 0x93,
+	0xf8, 0xbe, 0x92, 0x4b, 0xff, 0x94, 0x82, 0x7c, 0x88, 0x50, 0xf2, 0x1f, 0xa9, 0xe9, 0xf9, 0x8f,
+	0x74, 0x42, 0xfe, 0x63, 0x0b, 0x72, 0xac, 0x6b, 0xf7, 0xfb, 0x78, 0x69, 0x91, 0x49, 0x85, 0xa0,
+	0x8d, 0x82, 0xc5, 0xbd, 0x44, 0x6e, 0x5e, 0xd9, 0x1a, 0x79, 0x89, 0xd9, 0x09, 0x5e, 0x22, 0x3b,
+	0xc9, 0x4b, 0xcc, 0xa9, 0x5e, 0xa2, 0xf4, 0xf7, 0x69, 0x28, 0xa8, 0x75, 0x1a, 0x41, 0x92, 0x63,
+	0x52, 0x56, 0xf3, 0x36, 0xe4, 0xcd, 0x6e, 0xd7, 0xbd, 0x32, 0x9d, 0x70, 0x30, 0x23, 0x80, 0xac,
+	0x73, 0xe8, 0x77, 0xa9, 0x4f, 0x0d, 0x26, 0xaa, 0x49, 0xf9, 0x70, 0x03, 0x60, 0x93, 0xfa, 0x0c,
+	0x87, 0xdb, 0xf7, 0xdc, 0x0e, 0xa5, 0x16, 0x93, 0x89, 0x9e, 0xb0, 0x9d, 0xe4, 0xb4, 0xb2, 0x49,
+	0x4e, 0x8b, 0x7c, 0x06, 0x10, 0xd6, 0x9a, 0x04, 0xb5, 0x0c, 0xbb, 0x89, 0x85, 0x34, 0x1d, 0x5a,
+	0x0f, 0x08, 0x75, 0x85, 0x27, 0x21, 0x6a, 0xca, 0x25, 0x44, 0x4d, 0x9f, 0x67, 0x72, 0x33, 0xc5,
+	0x4c, 0x52, 0x81, 0xcb, 0xbf, 0xa5, 0x61, 0x63, 0x42, 0x3f, 0x13, 0xcd, 0xe1, 0x29, 0xe4, 0x98,
+	0xef, 0x99, 0x3e, 0x3d, 0x1b, 0xca, 0xc4, 0x5a, 0x42, 0xf1, 0x4f, 0x53, 0x52, 0xe8, 0x21, 0x6d,
+	0xec, 0x9a, 0x37, 0x33, 0x7e, 0xcd, 0x93, 0xc6, 0x90, 0x8d, 0x95, 0x93, 0x46, 0xf5, 0xc3, 0x5a,
+	0xa5, 0xc0, 0x52, 0xd4, 0x45, 0x98, 0x8b, 0x2d, 0xc2, 0x53, 0xd8, 0xf0, 0x68, 0xcf, 0xb4, 0x1d,
+	0x1c, 0x70, 0x74, 0x3d, 0xc5, 0x14, 0xad, 0x85, 0xe8, 0x8a, 0xb2, 0xb0, 0xa2, 0x16, 0xe8, 0xf3,
+	0x4c, 0x6e, 0xb6, 0x98, 0xd5, 0x77, 0x28, 0xf3, 0xed, 0x9e, 0xe9, 0x53, 0xcb, 0x08, 0xe4, 0x2a,
+	0x57, 0x05, 0xfd, 0x76, 0x02, 0x9a, 0x9a, 0x9d, 0x73, 0x9e, 0xbe, 0x2e, 0xfd, 0x43, 0x0a, 0xc8,
+	0xb8, 0xea, 0xb1, 0x59, 0x48, 0xc5, 0x67, 0x01, 0x8d, 0xaf, 0xeb, 0x32, 0x54, 0x9a, 0x3b, 0x48,
+	0x3e, 0xc3, 0x39, 0x7d, 0x41, 0x02, 0xb9, 0x1f, 0x98, 0x98, 0xa8, 0x54, 0xe7, 0x23, 0x13, 0x9b,
+	0x8f, 0xc7, 0x90, 0xed, 0xd2, 0x4b, 0xda, 0x0d, 0xf2, 0xdf, 0x53, 0x5e, 0x83, 0x25, 0x61, 0xe9,
+	0x33, 0x28, 0xc6, 0x71, 0xb8, 0x65, 0x85, 0x0f, 0x90, 0xe9, 0xb1, 0x7e, 0xf0, 0xa0, 0x68, 0xf6,
+	0x78, 0x4d, 0x90, 0x3c, 0xd7, 0x45, 0xab, 0x64, 0xc0, 0x6a, 0xd2, 0xfb, 0x2d, 0x79, 0x0e, 0x64,
+	0x54, 0x68, 0x61, 0x06, 0xaf, 0x4a, 0xa9, 0xeb, 0x14, 0x2b, 0x76, 0x63, 0x90, 0xd2, 0x9f, 0xa7,
+	0x60, 0x29, 0xa8, 0x49, 0x77, 0xcc, 0x3e, 0x3b, 0x77, 0x7d, 0xf2, 0x19, 0x2c, 0x49, 0x09, 0xe1,
+	0xa3, 0x45, 0x2a, 0xf6, 0xfe, 0x17, 0x2d, 0x63, 0xd7, 0x0b, 0xbd, 0x48, 0x9b, 0x3c, 0x85, 0x05,
+	0xe5, 0xf5, 0x62, 0x3c, 0xdd, 0xa6, 0x3c, 0x5f, 0xcc, 0x8f, 0x9e, 0x2f, 0x58, 0xe9, 0x0f, 0x83,
+	0xa7, 0x95, 0xda, 0x25, 0xdf, 0x9d, 0xef, 0x59, 0x9c, 0xfd, 0x31, 0x64, 0x29, 0x17, 0x24, 0x4f,
+	0xf1, 0xd5, 0x98, 0x02, 0xbc, 0x17, 0x5d, 0xd2, 0x94, 0x7e, 0x9e, 0x81, 0x79, 0x05, 0x8e, 0xb9,
+	0x6f, 0x5e, 0xf1, 0x95, 0x8a, 0xe5, 0xbe, 0x15, 0x1a, 0x5e, 0xf6, 0xc5, 0xa9, 0x46, 0xaa, 0xa6,
+	0x55, 0x55, 0x23, 0x5e, 0x74, 0x26, 0xe6, 0x45, 0xaf, 0x49, 0xdb, 0x1c, 0xc1, 0x7a, 0xac, 0xc0,
+	0xd7, 0x68, 0xd3, 0x53, 0xd7, 0xa3, 0x32, 0x8d, 0x33, 0x5a, 0x8d, 0x68, 0xfd, 0xab, 0xbe, 0xea,
+	0x45, 0xda, 0x07, 0x9c, 0x89, 0xbc, 0x84, 0xb5, 0xb8, 0x38, 0xf3, 0xd4, 0xa7, 0x9e, 0x96, 0x9d,
+	0x2e, 0x6d, 0x25, 0x2a, 0xad, 0x8c, 0x3c, 0x58, 0xaa, 0xa5, 0xbc, 0x0c, 0x49, 0xb5, 0x84, 0x07,
+	0x29, 0x8e, 0x10, 0xb2, 0xe7, 0xfb, 0xa0, 0xc0, 0x64, 0xa7, 0xc2, 0x85, 0x2c, 0x8d, 0xe0, 0x42,
+	0xee, 0x43, 0x20, 0x09, 0x31, 0x9f, 0xa8, 0x29, 0x5b, 0x1e, 0xab, 0x0c, 0x24, 0x5f, 0x83, 0xf5,
+	0xf0, 0x44, 0x08, 0x2e, 0xde, 0x42, 0x17, 0xe0, 0xf2, 0x57, 0x63, 0x19, 0x0c, 0xa1, 0xcf, 0x13,
+	0x58, 0x0b, 0xe1, 0x92, 0x4b, 0x28, 0x35, 0x2f, 0xb2, 0x5b, 0x51, 0x26, 0xae, 0x58, 0xe9, 0xaf,
+	0x52, 0xb0, 0xf4, 0xca, 0xb1, 0x2f, 0xa9, 0xc7, 0xe8, 0x0b, 0x9b, 0xf9, 0x58, 0x13, 0x96, 0x60,
+	0x87, 0xa9, 0x44, 0x3b, 0x7c, 0x0c, 0xd9, 0x73, 0x77, 0xe0, 0x75, 0x87, 0x63, 0xef, 0x33, 0x81,
+	0xc8, 0x60, 0xef, 0xe9, 0x92, 0x10, 0x4b, 0x4f, 0x2c, 0xd3, 0xee, 0x0e, 0xb5, 0x99, 0xeb, 0x38,
+	0x04, 0x5d, 0xe9, 0x57, 0x33, 0x50, 0x8c, 0xe3, 0x26, 0xec, 0x1f, 0x2c, 0x8e, 0x1b, 0x6d, 0x1a,
+	0xfe, 0x7b, 0xfc, 0x73, 0x81, 0x99, 0xdf, 0xe0, 0x73, 0x81, 0xcc, 0x7b, 0x7f, 0x2e, 0x30, 0x7b,
+	0xfd, 0xe7, 0x02, 0x0f, 0x60, 0x59, 0xb0, 0xa8, 0x89, 0x69, 0x51, 0xe4, 0xb8, 0xc4, 0x11, 0x8d,
+	0x51, 0x76, 0xfa, 0x8f, 0x6f, 0x52, 0x35, 0x1f, 0x2f, 0x37, 0x8e, 0xcf, 0xe2, 0xfb, 0xd6, 0xcc,
+	0x7f, 0xf1, 0xf5, 0xea, 0x3f, 0x0b, 0x1f, 0xde, 0x79, 0xe2, 0xfc, 0x90, 0x9a, 0x16, 0xf5, 0xda,
+	0xae, 0xe9, 0x59, 0xef, 0xeb, 0x31, 0xbf, 0x19, 0x7c, 0xbc, 0x14, 0x24, 0xf3, 0x93, 0x1d, 0x27,
+	0xef, 0x56, 0x5f, 0xe8, 0x8d, 0x1a, 0xac, 0xf4, 0x47, 0xe9, 0xc0, 0x7d, 0x72, 0x00, 0x26, 0xb1,
+	0x4d, 0xcb, 0xf2, 0x28, 0x63, 0x72, 0x50, 0x41, 0x93, 0x3c, 0x02, 0xb1, 0xa0, 0x06, 0x2f, 0x52,
+	0x99, 0x50, 0x3f, 0x01, 0x9c, 0x44, 0x14, 0x90, 0xdc, 0x0b, 0x1e, 0xd1, 0x99, 0xf1, 0xa3, 0x81,
+	0x8b, 0x0f, 0x7f, 0xc2, 0x3a, 0xa5, 0xae, 0xec, 0x7b, 0x1c, 0x18, 0x7f, 0xac, 0xc8, 0x8c, 0x3d,
+	0x56, 0xdc, 0x83, 0x42, 0x10, 0x87, 0xcb, 0xea, 0x1c, 0xf9, 0xc4, 0x28, 0xa1, 0xb2, 0x38, 0x87,
+	0x47, 0x01, 0x94, 0xf1, 0x04, 0x73, 0x36, 0x88, 0x02, 0x44, 0x1b, 0x7d, 0xf6, 0xd8, 0xb7, 0x46,
+	0xf9, 0x5e, 0xf8, 0x8d, 0xd1, 0x2f, 0x52, 0xb0, 0x5e, 0xf6, 0xda, 0xb6, 0x8f, 0xe2, 0x1a, 0x7d,
+	0x5c, 0x66, 0x7c, 0x19, 0xb5, 0xe9, 0x7b, 0x9f, 0x65, 0x15, 0xfc, 0xc4, 0x45, 0x91, 0x27, 0x57,
+	0x66, 0x94, 0xfa, 0x4e, 0xe8, 0x76, 0xa8, 0x47, 0x79, 0x4a, 0xff, 0x9e, 0x82, 0xd5, 0x24, 0x3a,
+	0xf2, 0x20, 0x72, 0xd6, 0xad, 0x8f, 0x0b, 0x55, 0x4e, 0xba, 0xc8, 0x99, 0x96, 0x9e, 0x7a, 0xa6,
+	0x8d, 0xc5, 0xa8, 0xc1, 0x1b, 0x73, 0x46, 0x79, 0x63, 0xe6, 0x53, 0x8a, 0xcf, 0xa9, 0xee, 0xa9,
+	0xed, 0xcb, 0x15, 0xc9, 0xf3, 0xf7, 0x54, 0x04, 0xe0, 0xc3, 0x91, 0x40, 0xf1, 0xda, 0x7b, 0xfe,
+	0x1a, 0x2d, 0x13, 0x9b, 0x02, 0x7c, 0x42, 0x3d, 0x7c, 0x82, 0x2e, 0xfd, 0x74, 0x11, 0x60, 0x14,
+	0x57, 0x8c, 0x95, 0x71, 0x6f, 0x41, 0x6e, 0x20, 0x37, 0x76, 0xa0, 0x74, 0xd0, 0x46, 0xc3, 0x89,
+	0x7e, 0x84, 0x81, 0x68, 0xb5, 0x9a, 0xfb, 0x2e, 0x2c, 0x38, 0x83, 0x5e, 0x10, 0xb7, 0x32, 0x59,
+	0xb8, 0x3d, 0xef, 0x0c, 0x7a, 0xf2, 0xba, 0xce, 0xa2, 0x2f, 0xc6, 0xb3, 0x72, 0x56, 0x12, 0x5f,
+	0x8c, 0xb3, 0x12, 0x19, 0xbc, 0x18, 0xdf, 0x87, 0x62, 0x67, 0xd0, 0x1b, 0x04, 0x65, 0x63, 0x1d,
+	0xb3, 0x2b, 0x8e, 0xd2, 0xbc, 0xbe, 0x34, 0x82, 0xe3, 0x5b, 0x2b, 0xfd, 0x3f, 0xa9, 0xc2, 0xbe,
+	0x0b, 0x21, 0x1b, 0xbf, 0x0f, 0x89, 0x02, 0xec, 0xf9, 0x00, 0x86, 0x39, 0xe4, 0xf1, 0x4b, 0x13,
+	0x2f, 0xc1, 0x8e, 0xa7, 0x9a, 0x3f, 0x06, 0x32, 0xf2, 0xb5, 0xa7, 0x94, 0xe2, 0x59, 0x4b, 0xb5,
+	0xc5, 0xa0, 0xa0, 0x5b, 0x62, 0x9e, 0x51, 0xaa, 0x8b, 0xc2, 0xf4, 0xa0, 0x08, 0x89, 0x77, 0xe5,
+	0x7a, 0x23, 0x96, 0x82, 0x5a, 0x84, 0x54, 0x11, 0xd8, 0x80, 0xed, 0x53, 0xd8, 0x1e, 0x67, 0x63,
+	0x46, 0xdb, 0xec, 0xf2, 0xcb, 0xa9, 0xa8, 0xe3, 0xd6, 0xe2, 0xac, 0xec, 0x40, 0xe0, 0x31, 0x82,
+	0x88, 0xb1, 0xf7, 0x4c, 0xbb, 0xdb, 0x76, 0xdf, 0x6a, 0xc5, 0x84, 0x4e, 0x8f, 0x04, 0x8e, 0x7c,
+	0x17, 0x6e, 0x27, 0x73, 0x19, 0xee, 0x95, 0x43, 0x3d, 0x6d, 0x99, 0xf3, 0x6e, 0x26, 0xf1, 0x36,
+	0x90, 0x00, 0xbf, 0x5d, 0xb4, 0x71, 0x4f, 0x9a, 0x5d, 0x79, 0x1c, 0x19, 0x7c, 0x5b, 0x10, 0xce,
+	0xb7, 0x2c, 0x51, 0xe2, 0x98, 0x68, 0xe2, 0x1e, 0x51, 0x4b, 0xd3, 0x57, 0x62, 0xa5, 0xe9, 0x41,
+	0xad, 0xfb, 0xaa, 0x52, 0xeb, 0xbe, 0x1e, 0x96, 0x83, 0xaf, 0x09, 0x43, 0x09, 0xcb, 0xbf, 0x89,
+	0x3b, 0xf0, 0x99, 0x6f, 0xca, 0x7a, 0x61, 0x71, 0x09, 0x5a, 0x17, 0xdd, 0x2a, 0x98, 0x51, 0x69,
+	0x1e, 0x2e, 0xc2, 0x95, 0xed, 0x58, 0xee, 0x15, 0x2f, 0xbf, 0xce, 0xeb, 0xf9, 0x53, 0x4a, 0xdf,
+	0x70, 0x40, 0xf0, 0x99, 0x01, 0xb7, 0x38, 0x2d, 0xfc, 0xcc, 0x40, 0xd6, 0xc1, 0x6f, 0x9e, 0xda,
+	0x4e, 0x78, 0xd0, 0x0b, 0x83, 0x33, 0x9c, 0x41, 0xaf, 0x4d, 0x3d, 0x5e, 0x46, 0x9d, 0xd1, 0x37,
+	0x54, 0x02, 0x6e, 0x7b, 0xc7, 0x1c, 0x8d, 0xc1, 0x65, 0x84, 0x97, 0xcb, 0xdf, 0xe2, 0x3c, 0x45,
+	0x15, 0xc1, 0x3b, 0xfa, 0x0c, 0x73, 0x05, 0xd1, 0x03, 0x7d, 0x7b, 0x7a, 0x40, 0x5b, 0x88, 0x06,
+	0xb4, 0x78, 0x50, 0x9d, 0xba, 0xde, 0x85, 0xed, 0x9c, 0x69, 0xb7, 0xf9, 0x5d, 0x31, 0x68, 0xa2,
+	0x73, 0x76, 0xf8, 0xa5, 0xb4, 0x67, 0x9f, 0x09, 0x57, 0xcc, 0xeb, 0x98, 0x73, 0x7a, 0x81, 0x83,
+	0x8f, 0x02, 0x28, 0xd6, 0x31, 0x58, 0x94, 0x75, 0x3c, 0xbb, 0xcf, 0x89, 0xee, 0x88, 0x2d, 0xa3,
+	0x80, 0xb0, 0x93, 0xe0, 0x73, 0x85, 0x0f, 0xc4, 0x69, 0x28, 0x9b, 0x93, 0x6a, 0x53, 0x76, 0x27,
+	0xd6, 0xa6, 0x3c, 0x82, 0x15, 0x8b, 0x32, 0xfb, 0xcc, 0xe1, 0x17, 0x67, 0x31, 0x18, 0xea, 0x69,
+	0x77, 0x05, 0xc3, 0x08, 0xa5, 0x4b, 0x0c, 0xde, 0xe4, 0xc7, 0x18, 0x70, 0xaa, 0x2e, 0xa8, 0x56,
+	0xe2, 0x4c, 0x6b, 0x71, 0xa6, 0x26, 0x22, 0x93, 0xbf, 0xc7, 0xf8, 0xd2, 0x84, 0xef, 0x31, 0xb6,
+	0x21, 0x8f, 0x2e, 0xd2, 0xb7, 0x3b, 0x17, 0x4c, 0xfb, 0x50, 0x98, 0xa8, 0x33, 0xe8, 0xb5, 0xb0,
+	0x8d, 0x48, 0x44, 0x08, 0x23, 0xbf, 0x27, 0x90, 0x08, 0xe0, 0xb6, 0xfd, 0x3b, 0x90, 0xef, 0xb8,
+	0x0e, 0xa3, 0x0e, 0x1b, 0x30, 0xed, 0xa3, 0x58, 0xa5, 0xf5, 0xb1, 0xeb, 0xf5, 0x70, 0xc1, 0xa9,
+	0x75, 0x62, 0x0e, 0xdd, 0x81, 0xaf, 0x8f, 0x68, 0xc9, 0x57, 0x21, 0x17, 0x7a, 0xe4, 0x2f, 0xc7,
+	0xe2, 0x14, 0xe9, 0x97, 0xf9, 0x15, 0x33, 0xa4, 0x42, 0x1f, 0xa3, 0x7c, 0xc5, 0x11, 0xb1, 0xc9,
+	0x3d, 0x6e, 0x5f, 0xab, 0xe1, 0xd7, 0x1c, 0xaa, 0x41, 0x26, 0x7c, 0xfc, 0x71, 0x3f, 0xe1, 0xe3,
+	0x8f, 0x52, 0x1d, 0x8a, 0x71, 0x7d, 0x63, 0x0f, 0xce, 0xa9, 0xd8, 0x83, 0x33, 0x6e, 0xd4, 0x3e,
+	0x27, 0xe4, 0x57, 0x83, 0xbc, 0x2e, 0x5b, 0xa5, 0x1e, 0xcc, 0x2b, 0x43, 0x50, 0x4e, 0xb3, 0x0c,
+	0x3f, 0xcd, 0x46, 0xfb, 0x3b, 0x1d, 0xd9, 0xdf, 0x61, 0x76, 0x41, 0x9c, 0x61, 0xa2, 0x11, 0x37,
+	0xcf, 0xcc, 0x98, 0x79, 0x3e, 0xf8, 0x5a, 0x70, 0x76, 0xf2, 0xe3, 0x2e, 0x0f, 0xb3, 0x3f, 0xa8,
+	0x35, 0x8f, 0x1b, 0xc5, 0x5b, 0x64, 0x09, 0xe6, 0x2b, 0xe5, 0x56, 0xed, 0x79, 0x43, 0xaf, 0x57,
+	0xca, 0x87, 0xc5, 0x14, 0x01, 0xc8, 0x36, 0x2b, 0xe5, 0xc3, 0xb2, 0x5e, 0x4c, 0x3f, 0xf8, 0x75,
+	0x0a, 0x0a, 0xb1, 0xef, 0x43, 0x97, 0x61, 0xf1, 0x44, 0xaf, 0x19, 0x7a, 0xed, 0xa4, 0xa1, 0xb7,
+	0xea, 0xc7, 0xcf, 0x8b, 0xb7, 0x88, 0x06, 0xab, 0xd5, 0x5a, 0xb3, 0xfe, 0xfc, 0xb8, 0xdc, 0xaa,
+	0x55, 0x15, 0x4c, 0x8a, 0x10, 0x28, 0x34, 0x4e, 0x6a, 0xc7, 0x0a, 0x2c, 0x4d, 0x36, 0x61, 0xad,
+	0xa2, 0x37, 0xde, 0x54, 0x9b, 0x8d, 0x57, 0x7a, 0xa5, 0x7e, 0xfc, 0xdc, 0xa8, 0xd6, 0x9b, 0x27,
+	0xaf, 0x5a, 0xb5, 0xe2, 0x0c, 0x0a, 0x2a, 0xbf, 0x29, 0xd7, 0x91, 0xd0, 0x38, 0xae, 0x7d, 0xbf,
+	0x65, 0xbc, 0xa9, 0x1f, 0x57, 0x1b, 0x6f, 0x8a, 0x19, 0x64, 0x0a, 0x31, 0xcf, 0xea, 0xc7, 0xe5,
+	0xc3, 0xfa, 0xef, 0x96, 0x5b, 0xf5, 0xc6, 0x71, 0x71, 0x96, 0x2c, 0x42, 0x5e, 0x42, 0x6a, 0xd5,
+	0x62, 0x96, 0xcc, 0xc3, 0xdc, 0xb3, 0x86, 0xfe, 0x12, 0xfb, 0x9a, 0x23, 0xbb, 0x70, 0x7b, 0x24,
+	0xb0, 0x21, 0xd5, 0x30, 0x8e, 0xea, 0xcf, 0x75, 0xc1, 0x9d, 0x23, 0xdb, 0xb0, 0x31, 0x12, 0xdc,
+	0xd0, 0x5f, 0x2a, 0xc8, 0xfc, 0x83, 0xbf, 0x0b, 0x73, 0x27, 0x61, 0x32, 0x00, 0x87, 0x74, 0x54,
+	0xd6, 0x5f, 0xd6, 0x5a, 0x46, 0x45, 0xaf, 0xe1, 0x80, 0x8b, 0xb7, 0x50, 0x48, 0x38, 0x42, 0xa3,
+	0xd9, 0x2a, 0xb7, 0x6a, 0x46, 0xe5, 0x45, 0xf9, 0xf8, 0x79, 0xad, 0x5a, 0x4c, 0x91, 0x15, 0x58,
+	0x92, 0x0a, 0x21, 0x4a, 0x47, 0x8e, 0x34, 0x59, 0x85, 0xe2, 0x89, 0x5e, 0xab, 0xd6, 0x2b, 0xd8,
+	0x93, 0x71, 0xd4, 0x78, 0x5d, 0xab, 0x16, 0x67, 0xc8, 0x1a, 0x2c, 0x37, 0xf4, 0x6a, 0x4d, 0x37,
+	0x0e, 0x1a, 0x8d, 0x97, 0x06, 0xce, 0x5c, 0xad, 0x5a, 0xcc, 0x90, 0x75, 0x20, 0x0a, 0xb8, 0x76,
+	0x74, 0xd2, 0xaa, 0xd7, 0xaa, 0xc5, 0x59, 0xb2, 0x01, 0x2b, 0x87, 0xf5, 0xef, 0xbd, 0xaa, 0x57,
+	0xeb, 0xad, 0x1f, 0x18, 0x95, 0xc6, 0xe1, 0x61, 0xf9, 0xa4, 0x89, 0x73, 0xf0, 0xe0, 0x87, 0xb0,
+	0x18, 0x89, 0xeb, 0xf8, 0x28, 0x9b, 0x2f, 0x9b, 0xc6, 0x41, 0xed, 0xb0, 0xf1, 0xc6, 0xa8, 0x34,
+	0x8e, 0x4e, 0x0e, 0x6b, 0xad, 0x9a, 0xd1, 0xac, 0xb5, 0x84, 0xf6, 0x07, 0xf5, 0x6a, 0xd3, 0x28,
+	0x1f, 0x34, 0x5e, 0xd7, 0xa2, 0xc8, 0x14, 0x29, 0xc2, 0x42, 0x45, 0x6f, 0x34, 0x9b, 0xb5, 0x2a,
+	0xef, 0xbd, 0x98, 0x7e, 0xf0, 0xd7, 0xbc, 0xb4, 0x30, 0x5a, 0x56, 0x82, 0xe3, 0x39, 0x2c, 0x37,
+	0x5b, 0x46, 0x4b, 0x2f, 0x57, 0x6b, 0xc6, 0x89, 0x5e, 0xaf, 0xd4, 0x8a, 0xb7, 0x50, 0x41, 0x45,
+	0xf1, 0xa3, 0x7a, 0xf5, 0xa4, 0x51, 0x3f, 0x46, 0xa9, 0x0b, 0x90, 0x3b, 0xa8, 0x35, 0x5b, 0xc6,
+	0x41, 0x1d, 0x27, 0x23, 0x68, 0x95, 0x9b, 0x2f, 0x8b, 0x33, 0xd8, 0x3a, 0x6e, 0x48, 0x11, 0x19,
+	0x92, 0x83, 0xcc, 0xeb, 0x37, 0xe5, 0x93, 0xe2, 0x2c, 0xfe, 0x6a, 0xe1, 0xaf, 0x2c, 0x5a, 0xef,
+	0xc1, 0x61, 0xed, 0xb8, 0x5a, 0x9c, 0xc3, 0x7e, 0xeb, 0xc7, 0xaf, 0xcb, 0x87, 0xf5, 0xaa, 0x51,
+	0x6b, 0xb6, 0xea, 0x47, 0xe5, 0x56, 0xad, 0x98, 0x7b, 0xf0, 0x1a, 0x56, 0x93, 0xea, 0x44, 0x70,
+	0xed, 0x2a, 0x8d, 0xe3, 0x67, 0xf5, 0x6a, 0xed, 0xb8, 0x52, 0x33, 0x0e, 0x1b, 0x6f, 0x8a, 0xb7,
+	0x70, 0xce, 0x15, 0xd8, 0x51, 0xad, 0x5a, 0x7f, 0x75, 0x24, 0x56, 0x4d, 0x01, 0xbf, 0xa8, 0x3f,
+	0x7f, 0x51, 0x4c, 0x3f, 0xd8, 0x83, 0xa5, 0x58, 0x5d, 0x24, 0xdf, 0x2d, 0x2f, 0xca, 0x7a, 0xad,
+	0x59, 0xbc, 0x85, 0x7a, 0xd5, 0x5a, 0x2f, 0x6a, 0x7a, 0x31, 0xf5, 0xe0, 0x13, 0x58, 0x1e, 0x4b,
+	0xf4, 0xe2, 0x56, 0x6b, 0xd6, 0x8f, 0x9f, 0x1f, 0xd6, 0xc4, 0x54, 0xde, 0x42, 0xd3, 0xad, 0x95,
+	0x2b, 0x2f, 0x44, 0x33, 0xd5, 0xce, 0xf2, 0xbf, 0x93, 0xf8, 0xe4, 0x7f, 0x07, 0x00, 0xa6, 0x9c,
+	0x58, 0x5b, 0x5f, 0x42, 0x00, 0x00,
 }
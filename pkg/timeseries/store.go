@@ -0,0 +1,307 @@
+package timeseries
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/stateshape/augur-analyzer/pkg/proto/markets"
+)
+
+const (
+	rawDirName    = "raw"
+	hourlyDirName = "hourly"
+	fileExtension = ".jsonl"
+
+	// How often points older than the raw retention are downsampled
+	compactionInterval = time.Hour
+)
+
+// Point holds the metrics of a market computed for one block. Prices and
+// amounts are in ETH.
+type Point struct {
+	Block uint64 `json:"block"`
+	Time  int64  `json:"time"`
+	// Percent by outcome, or value by outcome for scalar markets
+	Predictions map[uint64]float32 `json:"predictions,omitempty"`
+	// Estimated probability, in percent, of an invalid resolution
+	InvalidPercent       float32            `json:"invalidPercent,omitempty"`
+	MarketCapitalization float32            `json:"marketCapitalization"`
+	Volume               float32            `json:"volume"`
+	BestBids             map[uint64]float32 `json:"bestBids,omitempty"`
+	BestAsks             map[uint64]float32 `json:"bestAsks,omitempty"`
+	// Retention ratios by milliether tranche
+	RetentionRatios map[uint64]float32 `json:"retentionRatios,omitempty"`
+}
+
+// Store keeps a time series of points per market in append-only files, one
+// file per market. Points older than RawRetention are downsampled to the last
+// point of every hour and points older than Retention are deleted.
+type Store struct {
+	RawRetention time.Duration
+	Retention    time.Duration
+
+	dir            string
+	mu             sync.Mutex
+	lastCompaction time.Time
+}
+
+func NewStore(dir string, rawRetention, retention time.Duration) (*Store, error) {
+	for _, sub := range []string{rawDirName, hourlyDirName} {
+		if err := os.MkdirAll(filepath.Join(dir, sub), 0755); err != nil {
+			return nil, err
+		}
+	}
+	return &Store{
+		RawRetention: rawRetention,
+		Retention:    retention,
+		dir:          dir,
+	}, nil
+}
+
+// NewPoint extracts the metrics stored for a market
+func NewPoint(block uint64, now time.Time, m *markets.Market) *Point {
+	p := &Point{
+		Block:       block,
+		Time:        now.Unix(),
+		Predictions: map[uint64]float32{},
+		BestBids:    map[uint64]float32{},
+		BestAsks:    map[uint64]float32{},
+	}
+	for _, prediction := range m.Predictions {
+		if prediction.IsInvalid {
+			continue
+		}
+		if m.MarketType == markets.MarketType_SCALAR {
+			p.Predictions[prediction.OutcomeId] = prediction.Value
+			continue
+		}
+		p.Predictions[prediction.OutcomeId] = prediction.Percent
+	}
+	p.InvalidPercent = m.InvalidPercent
+	if m.MarketCapitalization != nil {
+		p.MarketCapitalization = m.MarketCapitalization.Eth
+	}
+	if m.Volume != nil {
+		p.Volume = m.Volume.Eth
+	}
+	for outcome, list := range m.Bids {
+		for i, lap := range list.LiquidityAtPrice {
+			if i == 0 || lap.Price > p.BestBids[outcome] {
+				p.BestBids[outcome] = lap.Price
+			}
+		}
+	}
+	for outcome, list := range m.Asks {
+		for i, lap := range list.LiquidityAtPrice {
+			if i == 0 || lap.Price < p.BestAsks[outcome] {
+				p.BestAsks[outcome] = lap.Price
+			}
+		}
+	}
+	if m.LiquidityMetrics != nil {
+		p.RetentionRatios = m.LiquidityMetrics.RetentionRatioByMillietherTranche
+	}
+	return p
+}
+
+// Append stores a point for every market and downsamples old points when the
+// last compaction is more than an hour old
+func (s *Store) Append(block uint64, now time.Time, ms []*markets.Market) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, m := range ms {
+		if err := validMarketID(m.Id); err != nil {
+			return err
+		}
+		if err := appendPoints(s.path(rawDirName, m.Id), []*Point{NewPoint(block, now, m)}); err != nil {
+			return err
+		}
+	}
+
+	if now.Sub(s.lastCompaction) < compactionInterval {
+		return nil
+	}
+	if err := s.compact(now); err != nil {
+		return err
+	}
+	s.lastCompaction = now
+	return nil
+}
+
+// Range returns the points of a market between from and to inclusive,
+// oldest first
+func (s *Store) Range(marketID string, from, to time.Time) ([]*Point, error) {
+	if err := validMarketID(marketID); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	points := []*Point{}
+	for _, sub := range []string{hourlyDirName, rawDirName} {
+		read, err := readPoints(s.path(sub, marketID))
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range read {
+			if p.Time >= from.Unix() && p.Time <= to.Unix() {
+				points = append(points, p)
+			}
+		}
+	}
+	sort.SliceStable(points, func(i, j int) bool { return points[i].Time < points[j].Time })
+	return points, nil
+}
+
+// compact moves raw points older than the raw retention to the hourly series
+// keeping the last point of each hour, and deletes points past the retention
+func (s *Store) compact(now time.Time) error {
+	files, err := ioutil.ReadDir(filepath.Join(s.dir, rawDirName))
+	if err != nil {
+		return err
+	}
+	hourly, err := ioutil.ReadDir(filepath.Join(s.dir, hourlyDirName))
+	if err != nil {
+		return err
+	}
+	marketIDs := map[string]struct{}{}
+	for _, f := range append(files, hourly...) {
+		if filepath.Ext(f.Name()) == fileExtension {
+			marketIDs[f.Name()[:len(f.Name())-len(fileExtension)]] = struct{}{}
+		}
+	}
+
+	rawCutoff, cutoff := now.Add(-s.RawRetention).Unix(), now.Add(-s.Retention).Unix()
+	for marketID := range marketIDs {
+		raw, err := readPoints(s.path(rawDirName, marketID))
+		if err != nil {
+			return err
+		}
+		old, recent := []*Point{}, []*Point{}
+		for _, p := range raw {
+			if p.Time < rawCutoff {
+				old = append(old, p)
+				continue
+			}
+			recent = append(recent, p)
+		}
+
+		hourly, err := readPoints(s.path(hourlyDirName, marketID))
+		if err != nil {
+			return err
+		}
+		kept := []*Point{}
+		for _, p := range downsample(append(hourly, old...), time.Hour) {
+			if p.Time >= cutoff {
+				kept = append(kept, p)
+			}
+		}
+
+		// Write the hourly series first so a crash in between
+		// duplicates points instead of losing them
+		if err := writePoints(s.path(hourlyDirName, marketID), kept); err != nil {
+			return err
+		}
+		if err := writePoints(s.path(rawDirName, marketID), recent); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// downsample keeps the last point of every interval. Metrics are snapshots
+// and volume is cumulative, so the last point summarizes an interval.
+func downsample(points []*Point, interval time.Duration) []*Point {
+	sort.SliceStable(points, func(i, j int) bool { return points[i].Time < points[j].Time })
+	seconds := int64(interval / time.Second)
+	downsampled := []*Point{}
+	for i, p := range points {
+		if i+1 < len(points) && points[i+1].Time/seconds == p.Time/seconds {
+			continue
+		}
+		downsampled = append(downsampled, p)
+	}
+	return downsampled
+}
+
+func (s *Store) path(sub, marketID string) string {
+	return filepath.Join(s.dir, sub, marketID+fileExtension)
+}
+
+// validMarketID rejects ids which cannot safely be used as file names
+func validMarketID(marketID string) error {
+	if marketID == "" {
+		return fmt.Errorf("Market id is empty")
+	}
+	for _, r := range marketID {
+		if !(r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z') {
+			return fmt.Errorf("Invalid market id: %s", marketID)
+		}
+	}
+	return nil
+}
+
+func readPoints(path string) ([]*Point, error) {
+	points := []*Point{}
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return points, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		p := &Point{}
+		if err := json.Unmarshal(scanner.Bytes(), p); err != nil {
+			// Skip a line truncated by a crash while appending
+			continue
+		}
+		points = append(points, p)
+	}
+	return points, scanner.Err()
+}
+
+func appendPoints(path string, points []*Point) error {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	encoder := json.NewEncoder(f)
+	for _, p := range points {
+		if err := encoder.Encode(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writePoints atomically replaces a file with the points provided, removing
+// it if there are none
+func writePoints(path string, points []*Point) error {
+	if len(points) == 0 {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	if err := os.Remove(path + ".tmp"); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err := appendPoints(path+".tmp", points); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
@@ -0,0 +1,89 @@
+package timeseries_test
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stateshape/augur-analyzer/pkg/proto/markets"
+	"github.com/stateshape/augur-analyzer/pkg/timeseries"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPoint(t *testing.T) {
+	p := timeseries.NewPoint(10, time.Unix(100, 0), &markets.Market{
+		Id:                   "0xabc",
+		MarketType:           markets.MarketType_YESNO,
+		Predictions:          []*markets.Prediction{{OutcomeId: 1, Percent: 60}, {OutcomeId: 2, Percent: 3, IsInvalid: true}},
+		InvalidPercent:       3,
+		MarketCapitalization: &markets.Price{Eth: 5},
+		Volume:               &markets.Price{Eth: 7},
+		Bids: map[uint64]*markets.ListLiquidityAtPrice{
+			1: {LiquidityAtPrice: []*markets.LiquidityAtPrice{{Price: 0.4, Amount: 1}, {Price: 0.55, Amount: 1}}},
+		},
+		Asks: map[uint64]*markets.ListLiquidityAtPrice{
+			1: {LiquidityAtPrice: []*markets.LiquidityAtPrice{{Price: 0.7, Amount: 1}, {Price: 0.65, Amount: 1}}},
+		},
+		LiquidityMetrics: &markets.LiquidityMetrics{
+			RetentionRatioByMillietherTranche: map[uint64]float32{100: 0.9},
+		},
+	})
+	assert.Equal(t, uint64(10), p.Block)
+	assert.Equal(t, int64(100), p.Time)
+	assert.Equal(t, float32(60), p.Predictions[1])
+	assert.Len(t, p.Predictions, 1)
+	assert.Equal(t, float32(3), p.InvalidPercent)
+	assert.Equal(t, float32(5), p.MarketCapitalization)
+	assert.Equal(t, float32(7), p.Volume)
+	assert.Equal(t, float32(0.55), p.BestBids[1])
+	assert.Equal(t, float32(0.65), p.BestAsks[1])
+	assert.Equal(t, float32(0.9), p.RetentionRatios[100])
+}
+
+func TestStoreRetentionAndDownsampling(t *testing.T) {
+	dir, err := ioutil.TempDir("", "timeseries")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	store, err := timeseries.NewStore(dir, 24*time.Hour, 72*time.Hour)
+	assert.Nil(t, err)
+
+	// A point every 20 minutes for four days
+	start := time.Unix(0, 0)
+	for i := 0; i < 4*24*3; i++ {
+		now := start.Add(time.Duration(i) * 20 * time.Minute)
+		m := &markets.Market{Id: "0xabc", Predictions: []*markets.Prediction{{OutcomeId: 1, Percent: float32(i)}}}
+		assert.Nil(t, store.Append(uint64(i), now, []*markets.Market{m}))
+	}
+	end := start.Add(4*24*time.Hour - 20*time.Minute)
+
+	points, err := store.Range("0xabc", start, end)
+	assert.Nil(t, err)
+
+	// Points older than the retention are deleted
+	assert.True(t, points[0].Time >= end.Add(-72*time.Hour-compactionSlack).Unix())
+
+	// Points older than the raw retention keep one point per hour
+	for i := 1; i < len(points); i++ {
+		assert.True(t, points[i].Time > points[i-1].Time)
+		if points[i].Time < end.Add(-24*time.Hour-compactionSlack).Unix() {
+			assert.Equal(t, int64(3600), points[i].Time-points[i-1].Time)
+			assert.Equal(t, int64(2400), points[i].Time%3600)
+		}
+	}
+	last := points[len(points)-1]
+	assert.Equal(t, end.Unix(), last.Time)
+	assert.Equal(t, float32(4*24*3-1), last.Predictions[1])
+
+	points, err = store.Range("0xabc", end.Add(-time.Hour), end)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(points))
+
+	_, err = store.Range("../secrets", start, end)
+	assert.NotNil(t, err)
+}
+
+// Compaction runs hourly so the cutoffs lag by up to an hour
+const compactionSlack = time.Hour
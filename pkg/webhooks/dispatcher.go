@@ -0,0 +1,351 @@
+package webhooks
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/stateshape/augur-analyzer/pkg/proto/markets"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/pborman/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	SignatureHeader    = "X-Analyzer-Signature"
+	SubscriptionHeader = "X-Analyzer-Subscription"
+	DeliveryHeader     = "X-Analyzer-Delivery"
+
+	QueueFileName       = "webhook-queue.json"
+	DeliveryLogFileName = "webhook-deliveries.log"
+
+	// Deliveries are dropped after this many failed attempts
+	MaxDeliveryAttempts = 10
+	// Backoff before the first retry, doubled on every further retry
+	InitialRetryBackoff = 30 * time.Second
+	// Number of most recent delivery attempts kept in memory
+	MaxDeliveryLogLength = 500
+	// Number of deliveries posted at the same time
+	MaxConcurrentDeliveries = 8
+	// Interval at which Run flushes deliveries due for a retry
+	FlushInterval = 10 * time.Second
+)
+
+// Delivery is a payload waiting to be posted to a subscription
+type Delivery struct {
+	ID             string          `json:"id"`
+	SubscriptionID string          `json:"subscriptionId"`
+	Block          uint64          `json:"block"`
+	Payload        json.RawMessage `json:"payload"`
+	Attempts       int             `json:"attempts"`
+	NextAttempt    time.Time       `json:"nextAttempt"`
+	CreatedAt      time.Time       `json:"createdAt"`
+}
+
+// DeliveryAttempt records the outcome of posting a delivery once
+type DeliveryAttempt struct {
+	DeliveryID     string    `json:"deliveryId"`
+	SubscriptionID string    `json:"subscriptionId"`
+	Block          uint64    `json:"block"`
+	Attempt        int       `json:"attempt"`
+	Time           time.Time `json:"time"`
+	StatusCode     int       `json:"statusCode,omitempty"`
+	Error          string    `json:"error,omitempty"`
+	Delivered      bool      `json:"delivered"`
+	Dropped        bool      `json:"dropped"`
+}
+
+// Dispatcher posts market events to webhook subscriptions. Pending
+// deliveries are persisted to disk so they survive restarts, and every
+// attempt is appended to a delivery log. Deliveries are posted by Run, away
+// from the processing of blocks, so slow subscribers do not hold it up.
+type Dispatcher struct {
+	Client *http.Client
+
+	subscriptions map[string]*Subscription
+	dataDir       string
+	wake          chan struct{}
+
+	// Held for the whole of a flush so deliveries are not posted twice
+	flushing sync.Mutex
+
+	mu    sync.Mutex
+	queue []*Delivery
+	log   []*DeliveryAttempt
+}
+
+// NewDispatcher creates a dispatcher storing its queue and delivery log in
+// dataDir, resuming any deliveries left in the queue by a previous run
+func NewDispatcher(subscriptions []*Subscription, dataDir string) (*Dispatcher, error) {
+	if err := os.MkdirAll(dataDir, 0755); err != nil {
+		return nil, err
+	}
+	d := &Dispatcher{
+		Client:        &http.Client{Timeout: 10 * time.Second},
+		subscriptions: map[string]*Subscription{},
+		dataDir:       dataDir,
+		wake:          make(chan struct{}, 1),
+		queue:         []*Delivery{},
+		log:           []*DeliveryAttempt{},
+	}
+	for _, subscription := range subscriptions {
+		d.subscriptions[subscription.ID] = subscription
+	}
+
+	content, err := ioutil.ReadFile(filepath.Join(dataDir, QueueFileName))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err == nil {
+		if err := json.Unmarshal(content, &d.queue); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+// Enqueue queues one delivery per subscription with the events matching its
+// filter and wakes Run to post them. categoryByMarketID is used to filter
+// events by market category.
+func (d *Dispatcher) Enqueue(block uint64, events []*markets.MarketEvent, categoryByMarketID map[string]string, now time.Time) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	marshaler := jsonpb.Marshaler{OrigName: true}
+	for _, subscription := range d.subscriptions {
+		matching := []*markets.MarketEvent{}
+		for _, event := range events {
+			if subscription.Filter.Matches(event, categoryByMarketID[event.MarketId]) {
+				matching = append(matching, event)
+			}
+		}
+		if len(matching) == 0 {
+			continue
+		}
+		payload, err := marshaler.MarshalToString(&markets.MarketEvents{
+			Block:          block,
+			GenerationTime: uint64(now.Unix()),
+			Events:         matching,
+		})
+		if err != nil {
+			return err
+		}
+		d.queue = append(d.queue, &Delivery{
+			ID:             uuid.New(),
+			SubscriptionID: subscription.ID,
+			Block:          block,
+			Payload:        json.RawMessage(payload),
+			NextAttempt:    now,
+			CreatedAt:      now,
+		})
+	}
+	if err := d.persistQueue(); err != nil {
+		return err
+	}
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Run flushes deliveries as soon as they are enqueued and every interval for
+// retries, until stop is closed. A nil stop runs it forever.
+func (d *Dispatcher) Run(interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		case <-d.wake:
+		}
+		if err := d.Flush(time.Now()); err != nil {
+			logrus.WithError(err).Errorf("Failed to flush webhook deliveries")
+			continue
+		}
+		logrus.Debugf("Flushed webhook deliveries, %d pending", d.Pending())
+	}
+}
+
+// Flush attempts every delivery which is due, rescheduling failed deliveries
+// with an exponential backoff until they run out of attempts. Up to
+// MaxConcurrentDeliveries deliveries are posted at the same time. Deliveries
+// stay in the persisted queue while they are being posted.
+func (d *Dispatcher) Flush(now time.Time) error {
+	d.flushing.Lock()
+	defer d.flushing.Unlock()
+
+	d.mu.Lock()
+	due := []Delivery{}
+	for _, delivery := range d.queue {
+		if !delivery.NextAttempt.After(now) {
+			due = append(due, *delivery)
+		}
+	}
+	d.mu.Unlock()
+
+	attempts := make([]*DeliveryAttempt, len(due))
+	slots := make(chan struct{}, MaxConcurrentDeliveries)
+	wg := sync.WaitGroup{}
+	for i := range due {
+		slots <- struct{}{}
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			attempts[i] = d.attempt(&due[i], now)
+		}(i)
+	}
+	wg.Wait()
+
+	attemptsByDeliveryID := map[string]*DeliveryAttempt{}
+	for _, attempt := range attempts {
+		attemptsByDeliveryID[attempt.DeliveryID] = attempt
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	remaining := []*Delivery{}
+	for _, delivery := range d.queue {
+		attempt, ok := attemptsByDeliveryID[delivery.ID]
+		if !ok {
+			remaining = append(remaining, delivery)
+			continue
+		}
+		if attempt.Delivered || attempt.Dropped {
+			continue
+		}
+		delivery.Attempts = attempt.Attempt
+		delivery.NextAttempt = now.Add(InitialRetryBackoff * time.Duration(1<<uint(attempt.Attempt-1)))
+		remaining = append(remaining, delivery)
+	}
+	d.queue = remaining
+
+	d.log = append(d.log, attempts...)
+	if len(d.log) > MaxDeliveryLogLength {
+		d.log = d.log[len(d.log)-MaxDeliveryLogLength:]
+	}
+	if err := d.appendDeliveryLog(attempts); err != nil {
+		return err
+	}
+	return d.persistQueue()
+}
+
+// Pending returns the number of deliveries waiting to be delivered
+func (d *Dispatcher) Pending() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return len(d.queue)
+}
+
+// DeliveryLog returns the most recent delivery attempts, oldest first
+func (d *Dispatcher) DeliveryLog() []*DeliveryAttempt {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	log := make([]*DeliveryAttempt, len(d.log))
+	copy(log, d.log)
+	return log
+}
+
+// attempt posts a delivery once, dropping it once it runs out of attempts
+func (d *Dispatcher) attempt(delivery *Delivery, now time.Time) *DeliveryAttempt {
+	attempt := &DeliveryAttempt{
+		DeliveryID:     delivery.ID,
+		SubscriptionID: delivery.SubscriptionID,
+		Block:          delivery.Block,
+		Attempt:        delivery.Attempts + 1,
+		Time:           now,
+	}
+	subscription, ok := d.subscriptions[delivery.SubscriptionID]
+	if !ok {
+		attempt.Error = "Subscription no longer exists"
+		attempt.Dropped = true
+	} else {
+		attempt.StatusCode, attempt.Delivered, attempt.Error = d.post(subscription, delivery)
+		if !attempt.Delivered && attempt.Attempt >= MaxDeliveryAttempts {
+			attempt.Dropped = true
+		}
+	}
+	if attempt.Dropped {
+		logrus.WithFields(logrus.Fields{
+			"subscriptionId": delivery.SubscriptionID,
+			"deliveryId":     delivery.ID,
+			"error":          attempt.Error,
+		}).Errorf("Dropping webhook delivery after %d attempts", attempt.Attempt)
+	}
+	return attempt
+}
+
+func (d *Dispatcher) post(subscription *Subscription, delivery *Delivery) (int, bool, string) {
+	request, err := http.NewRequest(http.MethodPost, subscription.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, false, err.Error()
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(SubscriptionHeader, subscription.ID)
+	request.Header.Set(DeliveryHeader, delivery.ID)
+	request.Header.Set(SignatureHeader, Sign(subscription.Secret, delivery.Payload))
+
+	response, err := d.Client.Do(request)
+	if err != nil {
+		return 0, false, err.Error()
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return response.StatusCode, false, fmt.Sprintf("Unexpected status code %d", response.StatusCode)
+	}
+	return response.StatusCode, true, ""
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of the payload, prefixed with the
+// name of the hash function. Receivers recompute it with the shared secret.
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// persistQueue atomically replaces the queue file with the current queue
+func (d *Dispatcher) persistQueue() error {
+	content, err := json.Marshal(d.queue)
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(d.dataDir, QueueFileName)
+	if err := ioutil.WriteFile(path+".tmp", content, 0644); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
+
+func (d *Dispatcher) appendDeliveryLog(attempts []*DeliveryAttempt) error {
+	if len(attempts) == 0 {
+		return nil
+	}
+	f, err := os.OpenFile(filepath.Join(d.dataDir, DeliveryLogFileName), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	encoder := json.NewEncoder(f)
+	for _, attempt := range attempts {
+		if err := encoder.Encode(attempt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
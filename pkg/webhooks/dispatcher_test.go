@@ -0,0 +1,142 @@
+package webhooks_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stateshape/augur-analyzer/pkg/proto/markets"
+	"github.com/stateshape/augur-analyzer/pkg/webhooks"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDispatcherFlush(t *testing.T) {
+	dataDir, err := ioutil.TempDir("", "webhooks")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dataDir)
+
+	status := http.StatusInternalServerError
+	received := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Equal(t, webhooks.Sign("secret", body), r.Header.Get(webhooks.SignatureHeader))
+		assert.Equal(t, "a", r.Header.Get(webhooks.SubscriptionHeader))
+		received++
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	subscriptions := []*webhooks.Subscription{{
+		ID:     "a",
+		URL:    server.URL,
+		Secret: "secret",
+		Filter: webhooks.Filter{EventTypes: []string{"MARKET_CREATED"}},
+	}}
+	d, err := webhooks.NewDispatcher(subscriptions, dataDir)
+	assert.Nil(t, err)
+
+	now := time.Unix(1000, 0)
+	events := []*markets.MarketEvent{
+		{Type: markets.MarketEventType_MARKET_CREATED, Block: 1, MarketId: "m"},
+		{Type: markets.MarketEventType_PREDICTION_MOVED, Block: 1, MarketId: "m"},
+	}
+	assert.Nil(t, d.Enqueue(1, events, map[string]string{"m": "sports"}, now))
+	assert.Equal(t, 1, d.Pending())
+
+	// Failed deliveries are retried after a backoff
+	assert.Nil(t, d.Flush(now))
+	assert.Equal(t, 1, received)
+	assert.Equal(t, 1, d.Pending())
+	assert.Nil(t, d.Flush(now.Add(webhooks.InitialRetryBackoff-time.Second)))
+	assert.Equal(t, 1, received)
+
+	// The queue survives a restart
+	d, err = webhooks.NewDispatcher(subscriptions, dataDir)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, d.Pending())
+
+	status = http.StatusOK
+	assert.Nil(t, d.Flush(now.Add(webhooks.InitialRetryBackoff)))
+	assert.Equal(t, 2, received)
+	assert.Equal(t, 0, d.Pending())
+
+	log := d.DeliveryLog()
+	assert.Equal(t, 1, len(log))
+	assert.Equal(t, 2, log[0].Attempt)
+	assert.True(t, log[0].Delivered)
+}
+
+func TestDispatcherDropsDeliveries(t *testing.T) {
+	dataDir, err := ioutil.TempDir("", "webhooks")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dataDir)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	d, err := webhooks.NewDispatcher([]*webhooks.Subscription{{ID: "a", URL: server.URL}}, dataDir)
+	assert.Nil(t, err)
+
+	now := time.Unix(1000, 0)
+	events := []*markets.MarketEvent{{Type: markets.MarketEventType_MARKET_CREATED, Block: 1, MarketId: "m"}}
+	assert.Nil(t, d.Enqueue(1, events, map[string]string{}, now))
+	for attempt := 0; attempt < webhooks.MaxDeliveryAttempts; attempt++ {
+		assert.Equal(t, 1, d.Pending())
+		now = now.Add(24 * 365 * time.Hour)
+		assert.Nil(t, d.Flush(now))
+	}
+	assert.Equal(t, 0, d.Pending())
+
+	log := d.DeliveryLog()
+	assert.Equal(t, webhooks.MaxDeliveryAttempts, len(log))
+	assert.True(t, log[len(log)-1].Dropped)
+	assert.Equal(t, http.StatusServiceUnavailable, log[len(log)-1].StatusCode)
+}
+
+func TestDispatcherRun(t *testing.T) {
+	dataDir, err := ioutil.TempDir("", "webhooks")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dataDir)
+
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	d, err := webhooks.NewDispatcher([]*webhooks.Subscription{{ID: "a", URL: server.URL}}, dataDir)
+	assert.Nil(t, err)
+	stop := make(chan struct{})
+	defer close(stop)
+	go d.Run(time.Hour, stop)
+
+	// Enqueueing does not wait for a slow subscriber
+	events := []*markets.MarketEvent{{Type: markets.MarketEventType_MARKET_CREATED, Block: 1, MarketId: "m"}}
+	assert.Nil(t, d.Enqueue(1, events, map[string]string{}, time.Now()))
+	assert.Nil(t, d.Enqueue(2, events, map[string]string{}, time.Now()))
+	assert.Equal(t, 2, d.Pending())
+	close(release)
+
+	// Delivered without waiting for the interval
+	deadline := time.Now().Add(5 * time.Second)
+	for d.Pending() > 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, 0, d.Pending())
+}
+
+func TestFilterMatches(t *testing.T) {
+	event := &markets.MarketEvent{Type: markets.MarketEventType_ORDER_BOOK_OPENED, MarketId: "m"}
+
+	assert.True(t, (&webhooks.Filter{}).Matches(event, "sports"))
+	assert.True(t, (&webhooks.Filter{Categories: []string{"sports"}, MarketIDs: []string{"m"}}).Matches(event, "sports"))
+	assert.False(t, (&webhooks.Filter{Categories: []string{"politics"}}).Matches(event, "sports"))
+	assert.False(t, (&webhooks.Filter{EventTypes: []string{"MARKET_CREATED"}}).Matches(event, "sports"))
+}
@@ -0,0 +1,74 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/stateshape/augur-analyzer/pkg/proto/markets"
+)
+
+// Subscription receives the market events matching its filter at its URL.
+// Payloads are signed with the secret of the subscription.
+type Subscription struct {
+	ID     string `json:"id"`
+	URL    string `json:"url"`
+	Secret string `json:"secret"`
+	Filter Filter `json:"filter"`
+}
+
+// Filter selects market events, an empty list matches everything
+type Filter struct {
+	// Names of MarketEventType values, e.g. "MARKET_CREATED"
+	EventTypes []string `json:"eventTypes"`
+	MarketIDs  []string `json:"marketIds"`
+	Categories []string `json:"categories"`
+}
+
+// LoadSubscriptions reads a JSON list of subscriptions from a file
+func LoadSubscriptions(path string) ([]*Subscription, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	subscriptions := []*Subscription{}
+	if err := json.Unmarshal(content, &subscriptions); err != nil {
+		return nil, err
+	}
+	ids := map[string]struct{}{}
+	for _, subscription := range subscriptions {
+		if subscription.ID == "" || subscription.URL == "" {
+			return nil, fmt.Errorf("Webhook subscriptions require an id and a url")
+		}
+		if _, ok := ids[subscription.ID]; ok {
+			return nil, fmt.Errorf("Duplicate webhook subscription id: %s", subscription.ID)
+		}
+		ids[subscription.ID] = struct{}{}
+		for _, eventType := range subscription.Filter.EventTypes {
+			if _, ok := markets.MarketEventType_value[eventType]; !ok {
+				return nil, fmt.Errorf("Unknown market event type in webhook subscription %s: %s", subscription.ID, eventType)
+			}
+		}
+	}
+	return subscriptions, nil
+}
+
+// Matches returns true if the event passes the filter. The category
+// of the market the event is about is needed to filter by category.
+func (f *Filter) Matches(event *markets.MarketEvent, category string) bool {
+	return matchesAny(f.EventTypes, event.Type.String()) &&
+		matchesAny(f.MarketIDs, event.MarketId) &&
+		matchesAny(f.Categories, category)
+}
+
+func matchesAny(allowed []string, value string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, a := range allowed {
+		if a == value {
+			return true
+		}
+	}
+	return false
+}